@@ -1235,7 +1235,27 @@ func DurationFrom(l *DurationLiteral, _ time.Time) (time.Duration, error) {
 	return d, nil
 }
 
-// TODO: we need a "duration from" that takes a time and a durationliteral, and gives an exact time.Duration instead of an approximation
+// MonthsFrom splits a duration literal into a number of calendar months and an exact time.Duration.
+// The y and mo units contribute to the months, all other units contribute to the time.Duration.
+func MonthsFrom(l *DurationLiteral) (int64, time.Duration, error) {
+	var months int64
+	var d time.Duration
+	for _, v := range l.Values {
+		switch v.Unit {
+		case "y":
+			months += v.Magnitude * 12
+		case "mo":
+			months += v.Magnitude
+		default:
+			tempD, err := toDuration(v)
+			if err != nil {
+				return 0, 0, err
+			}
+			d += tempD
+		}
+	}
+	return months, d, nil
+}
 
 // DateTimeLiteral represents an instant in time with nanosecond precision using
 // the syntax of golang's RFC3339 Nanosecond variant
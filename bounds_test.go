@@ -20,7 +20,7 @@ func TestBounds_HasZero(t *testing.T) {
 			bounds: flux.Bounds{
 				Start: flux.Time{
 					IsRelative: true,
-					Relative:   -1 * time.Hour,
+					Relative:   flux.ConvertDuration(-1 * time.Hour),
 				},
 				Stop: flux.Time{},
 			},
@@ -41,7 +41,7 @@ func TestBounds_HasZero(t *testing.T) {
 			bounds: flux.Bounds{
 				Start: flux.Time{
 					IsRelative: true,
-					Relative:   -1 * time.Hour,
+					Relative:   flux.ConvertDuration(-1 * time.Hour),
 				},
 				Stop: flux.Now,
 			},
@@ -90,7 +90,7 @@ func TestBounds_IsEmpty(t *testing.T) {
 			bounds: flux.Bounds{
 				Start: flux.Time{
 					IsRelative: true,
-					Relative:   time.Hour,
+					Relative:   flux.ConvertDuration(time.Hour),
 				},
 				Stop: flux.Now,
 				Now:  time.Date(2018, time.August, 14, 11, 0, 0, 0, time.UTC),
@@ -102,7 +102,7 @@ func TestBounds_IsEmpty(t *testing.T) {
 			bounds: flux.Bounds{
 				Start: flux.Time{
 					IsRelative: true,
-					Relative:   -1 * time.Hour,
+					Relative:   flux.ConvertDuration(-1 * time.Hour),
 				},
 				Stop: flux.Now,
 				Now:  time.Date(2018, time.August, 14, 11, 0, 0, 0, time.UTC),
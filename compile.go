@@ -88,6 +88,7 @@ var (
 		"influxdata/influxdb",
 		"math",
 		"strings",
+		"date",
 	}
 	preludeScope = &scopeSet{
 		packages: make([]*interpreter.Package, len(prelude)),
@@ -672,18 +673,18 @@ func (a Arguments) GetRequiredTime(name string) (Time, error) {
 func (a Arguments) GetDuration(name string) (Duration, bool, error) {
 	v, ok := a.Get(name)
 	if !ok {
-		return 0, false, nil
+		return Duration{}, false, nil
 	}
-	return Duration(v.Duration()), true, nil
+	return v.Duration(), true, nil
 }
 
 func (a Arguments) GetRequiredDuration(name string) (Duration, error) {
 	d, ok, err := a.GetDuration(name)
 	if err != nil {
-		return 0, err
+		return Duration{}, err
 	}
 	if !ok {
-		return 0, fmt.Errorf("missing required keyword argument %q", name)
+		return Duration{}, fmt.Errorf("missing required keyword argument %q", name)
 	}
 	return d, nil
 }
@@ -696,7 +697,7 @@ func ToQueryTime(value values.Value) (Time, error) {
 		}, nil
 	case semantic.Duration:
 		return Time{
-			Relative:   value.Duration().Duration(),
+			Relative:   value.Duration(),
 			IsRelative: true,
 		}, nil
 	case semantic.Int:
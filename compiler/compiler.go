@@ -227,7 +227,7 @@ func compile(n semantic.Node, typeSol semantic.TypeSolution, builtIns Scope, fun
 	case *semantic.DurationLiteral:
 		return &durationEvaluator{
 			t:        monoType(typeSol.TypeOf(n)),
-			duration: values.Duration(n.Value),
+			duration: values.MakeDuration(int64(n.Value), n.Months),
 		}, nil
 	case *semantic.UnaryExpression:
 		node, err := compile(n.Argument, typeSol, builtIns, funcExprs)
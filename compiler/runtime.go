@@ -121,7 +121,7 @@ func (c compiledFn) EvalTime(input values.Object) (values.Time, error) {
 }
 func (c compiledFn) EvalDuration(input values.Object) (values.Duration, error) {
 	if err := c.buildScope(input); err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	return c.root.EvalDuration(c.inputScope)
 }
@@ -342,7 +342,7 @@ func (e *blockEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	values.CheckKind(e.t.Nature(), semantic.Duration)
 	err := e.eval(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	return e.value.Duration(), nil
 }
@@ -450,7 +450,7 @@ func (e *declarationEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *declarationEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	err := e.eval(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 
 	return scope.GetDuration(e.id), nil
@@ -721,7 +721,7 @@ func (e *conditionalEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *conditionalEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	v, err := e.eval(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	return v.Duration(), nil
 }
@@ -821,7 +821,7 @@ func (e *binaryEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *binaryEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	l, r, err := e.eval(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	return e.f(l, r).Duration(), nil
 }
@@ -883,10 +883,10 @@ func (e *unaryEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *unaryEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	v, err := e.node.EvalDuration(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	// There is only one duration unary operator
-	return -v, nil
+	return v.Neg(), nil
 }
 func (e *unaryEvaluator) EvalRegexp(scope Scope) (*regexp.Regexp, error) {
 	panic(values.UnexpectedKind(e.t.Nature(), semantic.Regexp))
@@ -1348,7 +1348,7 @@ func (e *memberEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *memberEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	o, err := e.object.EvalObject(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	v, _ := o.Get(e.property)
 	return v.Duration(), nil
@@ -1453,7 +1453,7 @@ func (e *arrayIndexEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *arrayIndexEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	v, err := e.eval(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	return v.Duration(), nil
 }
@@ -1553,7 +1553,7 @@ func (e *callEvaluator) EvalTime(scope Scope) (values.Time, error) {
 func (e *callEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
 	v, err := e.eval(scope)
 	if err != nil {
-		return 0, err
+		return values.Duration{}, err
 	}
 	return v.Duration(), nil
 }
@@ -1750,7 +1750,7 @@ func (noopEvaluator) EvalTime(scope Scope) (values.Time, error) {
 }
 
 func (noopEvaluator) EvalDuration(scope Scope) (values.Duration, error) {
-	return 0, nil
+	return values.Duration{}, nil
 }
 
 func (noopEvaluator) EvalRegexp(scope Scope) (*regexp.Regexp, error) {
@@ -20,11 +20,14 @@ package control
 import (
 	"context"
 	"fmt"
+	"os"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/lang"
 	"github.com/influxdata/flux/memory"
@@ -40,19 +43,28 @@ type Controller struct {
 	lastID     uint64
 	queriesMu  sync.RWMutex
 	queries    map[QueryID]*Query
-	queryQueue chan *Query
+	queryQueue *queryQueue
 	wg         sync.WaitGroup
+	state      controllerState
 	shutdown   bool
+	draining   bool
+	drained    chan struct{}
 	done       chan struct{}
+	doneOnce   sync.Once
 	abortOnce  sync.Once
 	abort      chan struct{}
 
 	memoryBytesQuotaPerQuery int64
+	memoryPool               *memoryPool
 
 	metrics   *controllerMetrics
 	labelKeys []string
+	tenantKey string
+	timeouts  timeouts
 
-	logger *zap.Logger
+	logger             *zap.Logger
+	queryLogger        QueryLogger
+	slowQueryThreshold time.Duration
 
 	dependencies execute.Dependencies
 }
@@ -63,21 +75,100 @@ type Config struct {
 	// MemoryBytesQuotaPerQuery is the maximum number of bytes (in table memory) a query is allowed to use at
 	// any given time.
 	//
-	// The maximum amount of memory the controller is allowed to consume is
+	// Unless MaxMemoryBytes is set, the maximum amount of memory the controller is allowed to consume is
 	//   ConcurrencyQuota * MemoryBytesQuotaPerQuery
 	MemoryBytesQuotaPerQuery int64
+	// QueueTimeout is the default time a query is allowed to wait in the queue before it fails.
+	// A zero value allows queries to wait until they are executed.
+	QueueTimeout time.Duration
+	// ExecuteTimeout is the default time a query is allowed to execute before it is canceled and fails.
+	// A zero value allows queries to execute until they are finished.
+	// The timeouts of a query are set on its context with WithTimeouts.
+	ExecuteTimeout time.Duration
+	// MaxMemoryBytes is the size of the memory pool shared by all of the executing queries.
+	// Queries borrow memory from the pool as they need it, up to MemoryBytesQuotaPerQuery,
+	// and queries are queued until the pool has enough memory for their initial reservation.
+	// A zero value disables the pool, so each query may use MemoryBytesQuotaPerQuery.
+	MaxMemoryBytes int64
+	// InitialMemoryBytesQuotaPerQuery is the number of bytes reserved from the memory pool
+	// before a query is executed. A zero value reserves MemoryBytesQuotaPerQuery.
+	InitialMemoryBytesQuotaPerQuery int64
+	// SpillDirectory is the directory where sort, pivot and join write temporary files
+	// when a query is about to exceed MemoryBytesQuotaPerQuery, instead of failing the query.
+	// Spilling to disk is disabled when it is empty.
+	SpillDirectory string
 	// QueueSize is the number of queries that are allowed to be awaiting execution before new queries are
 	// rejected.
 	QueueSize int
-	Logger    *zap.Logger
+	// PriorityAgingInterval is the amount of time a query must wait in the queue
+	// to be executed ahead of newly queued queries with a priority one level higher than its own.
+	// The priority of a query is set on its context with WithPriority, or in its resources.
+	// Queries with a priority above 100, such as flux.Low, age as if their priority was 100.
+	// A zero value disables aging, so queued queries are executed strictly by priority.
+	PriorityAgingInterval time.Duration
+	Logger                *zap.Logger
 	// MetricLabelKeys is a list of labels to add to the metrics produced by the controller.
 	// The value for a given key will be read off the context.
 	// The context value must be a string or an implementation of the Stringer interface.
 	MetricLabelKeys []string
+	// TenantLabelKey is the key of the context value that identifies the tenant of a query.
+	// The context value must be a string or an implementation of the Stringer interface.
+	// Queries without a tenant, or all queries if the key is empty, belong to the empty tenant.
+	TenantLabelKey string
+	// TenantQuotas is the quota of each tenant by name.
+	// Tenants that are not listed use the DefaultTenantQuota.
+	TenantQuotas map[string]TenantQuota
+	// DefaultTenantQuota is the quota of the tenants that are not listed in TenantQuotas.
+	DefaultTenantQuota TenantQuota
+	// SlowQueryThreshold is the total duration above which a finished query is considered slow.
+	// Slow queries are logged at the info level and all other queries at the debug level.
+	// A zero value does not consider any query to be slow.
+	SlowQueryThreshold time.Duration
+	// QueryLogger receives an entry for every finished query.
+	// When it is nil, the entries are written to the Logger.
+	QueryLogger QueryLogger
 
 	ExecutorDependencies execute.Dependencies
 }
 
+// TenantQuota is the share of the controller resources that is given to a tenant.
+type TenantQuota struct {
+	// Weight is the share of the executed queries that are taken from this tenant,
+	// relative to the weights of the other tenants with queued queries.
+	// A zero value is the same as a weight of one.
+	Weight int
+	// ConcurrencyQuota is the number of queries of this tenant that are allowed to execute concurrently.
+	// A zero value indicates that the tenant is limited only by the ConcurrencyQuota of the controller.
+	ConcurrencyQuota int
+	// MemoryBytesQuota is the number of bytes of memory reserved by the executing queries of this tenant.
+	// Each executing query reserves MemoryBytesQuotaPerQuery.
+	// A zero value indicates that the memory of the tenant is not limited.
+	MemoryBytesQuota int64
+	// QueueSize is the number of queries of this tenant that are allowed to be awaiting execution
+	// before its new queries are rejected.
+	// A zero value indicates that the tenant is limited only by the QueueSize of the controller.
+	QueueSize int
+}
+
+func (q TenantQuota) validate(memoryBytesQuotaPerQuery int64) error {
+	if q.Weight < 0 {
+		return errors.New("Weight must not be negative")
+	}
+	if q.ConcurrencyQuota < 0 {
+		return errors.New("ConcurrencyQuota must not be negative")
+	}
+	if q.MemoryBytesQuota < 0 {
+		return errors.New("MemoryBytesQuota must not be negative")
+	}
+	if q.MemoryBytesQuota > 0 && q.MemoryBytesQuota < memoryBytesQuotaPerQuery {
+		return errors.New("MemoryBytesQuota must not be less than MemoryBytesQuotaPerQuery")
+	}
+	if q.QueueSize < 0 {
+		return errors.New("QueueSize must not be negative")
+	}
+	return nil
+}
+
 func (c *Config) Validate() error {
 	if c.ConcurrencyQuota <= 0 {
 		return errors.New("ConcurrencyQuota must be positive")
@@ -88,9 +179,61 @@ func (c *Config) Validate() error {
 	if c.QueueSize <= 0 {
 		return errors.New("QueueSize must be positive")
 	}
+	if c.QueueTimeout < 0 {
+		return errors.New("QueueTimeout must not be negative")
+	}
+	if c.ExecuteTimeout < 0 {
+		return errors.New("ExecuteTimeout must not be negative")
+	}
+	if c.MaxMemoryBytes < 0 {
+		return errors.New("MaxMemoryBytes must not be negative")
+	}
+	if c.InitialMemoryBytesQuotaPerQuery < 0 {
+		return errors.New("InitialMemoryBytesQuotaPerQuery must not be negative")
+	}
+	if c.InitialMemoryBytesQuotaPerQuery > 0 && c.MaxMemoryBytes == 0 {
+		return errors.New("InitialMemoryBytesQuotaPerQuery requires MaxMemoryBytes to be set")
+	}
+	if c.InitialMemoryBytesQuotaPerQuery > c.MemoryBytesQuotaPerQuery {
+		return errors.New("InitialMemoryBytesQuotaPerQuery must not be greater than MemoryBytesQuotaPerQuery")
+	}
+	if c.MaxMemoryBytes > 0 && c.MaxMemoryBytes < c.initialMemoryBytesQuotaPerQuery() {
+		return errors.New("MaxMemoryBytes must not be less than the initial memory of a query")
+	}
+	if c.PriorityAgingInterval < 0 {
+		return errors.New("PriorityAgingInterval must not be negative")
+	}
+	if c.SlowQueryThreshold < 0 {
+		return errors.New("SlowQueryThreshold must not be negative")
+	}
+	for tenant, quota := range c.TenantQuotas {
+		if err := quota.validate(c.MemoryBytesQuotaPerQuery); err != nil {
+			return errors.Wrapf(err, "invalid quota for tenant %q", tenant)
+		}
+	}
+	if err := c.DefaultTenantQuota.validate(c.MemoryBytesQuotaPerQuery); err != nil {
+		return errors.Wrap(err, "invalid DefaultTenantQuota")
+	}
+	if c.SpillDirectory != "" {
+		info, err := os.Stat(c.SpillDirectory)
+		if err != nil {
+			return errors.Wrap(err, "invalid SpillDirectory")
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("SpillDirectory %q is not a directory", c.SpillDirectory)
+		}
+	}
 	return nil
 }
 
+// initialMemoryBytesQuotaPerQuery returns the memory reserved from the pool before a query is executed.
+func (c *Config) initialMemoryBytesQuotaPerQuery() int64 {
+	if c.InitialMemoryBytesQuotaPerQuery > 0 {
+		return c.InitialMemoryBytesQuotaPerQuery
+	}
+	return c.MemoryBytesQuotaPerQuery
+}
+
 type QueryID uint64
 
 func New(c Config) (*Controller, error) {
@@ -104,18 +247,62 @@ func New(c Config) (*Controller, error) {
 	logger.Info("Starting query controller",
 		zap.Int("concurrency_quota", c.ConcurrencyQuota),
 		zap.Int64("memory_bytes_quota_per_query", c.MemoryBytesQuotaPerQuery),
-		zap.Int("queue_size", c.QueueSize))
+		zap.Int64("max_memory_bytes", c.MaxMemoryBytes),
+		zap.Int64("initial_memory_bytes_quota_per_query", c.InitialMemoryBytesQuotaPerQuery),
+		zap.Int("queue_size", c.QueueSize),
+		zap.Duration("queue_timeout", c.QueueTimeout),
+		zap.Duration("execute_timeout", c.ExecuteTimeout),
+		zap.Duration("priority_aging_interval", c.PriorityAgingInterval),
+		zap.String("tenant_label_key", c.TenantLabelKey),
+		zap.Int("tenant_quotas", len(c.TenantQuotas)),
+		zap.Duration("slow_query_threshold", c.SlowQueryThreshold),
+		zap.String("spill_directory", c.SpillDirectory))
+	dependencies := c.ExecutorDependencies
+	if c.SpillDirectory != "" {
+		// Copy the dependencies so that the configuration of the caller is not modified.
+		dependencies = make(execute.Dependencies, len(c.ExecutorDependencies)+1)
+		for k, v := range c.ExecutorDependencies {
+			dependencies[k] = v
+		}
+		dependencies[execute.SpillConfigKey] = &execute.SpillConfig{Dir: c.SpillDirectory}
+	}
+	queryLogger := c.QueryLogger
+	if queryLogger == nil {
+		queryLogger = zapQueryLogger{logger: logger}
+	}
+	metrics := newControllerMetrics(c.MetricLabelKeys)
+	var pool *memoryPool
+	if c.MaxMemoryBytes > 0 {
+		pool = newMemoryPool(c.MaxMemoryBytes, c.initialMemoryBytesQuotaPerQuery(), c.MemoryBytesQuotaPerQuery, metrics.memoryUnused)
+	}
 	ctrl := &Controller{
-		queries:                  make(map[QueryID]*Query),
-		queryQueue:               make(chan *Query, c.QueueSize),
+		queries: make(map[QueryID]*Query),
+		queryQueue: newQueryQueue(queueConfig{
+			size:                c.QueueSize,
+			aging:               c.PriorityAgingInterval,
+			memoryBytesPerQuery: c.MemoryBytesQuotaPerQuery,
+			quotas:              c.TenantQuotas,
+			defaultQuota:        c.DefaultTenantQuota,
+			pool:                pool,
+		}),
+		drained:                  make(chan struct{}),
 		done:                     make(chan struct{}),
 		abort:                    make(chan struct{}),
 		memoryBytesQuotaPerQuery: c.MemoryBytesQuotaPerQuery,
 		logger:                   logger,
-		metrics:                  newControllerMetrics(c.MetricLabelKeys),
+		queryLogger:              queryLogger,
+		slowQueryThreshold:       c.SlowQueryThreshold,
+		memoryPool:               pool,
+		metrics:                  metrics,
 		labelKeys:                c.MetricLabelKeys,
-		dependencies:             c.ExecutorDependencies,
+		tenantKey:                c.TenantLabelKey,
+		timeouts: timeouts{
+			queue:   c.QueueTimeout,
+			execute: c.ExecuteTimeout,
+		},
+		dependencies: dependencies,
 	}
+	ctrl.setState(controllerRunning)
 	ctrl.wg.Add(c.ConcurrencyQuota)
 	for i := 0; i < c.ConcurrencyQuota; i++ {
 		go func() {
@@ -129,7 +316,7 @@ func New(c Config) (*Controller, error) {
 // Query submits a query for execution returning immediately.
 // Done must be called on any returned Query objects.
 func (c *Controller) Query(ctx context.Context, compiler flux.Compiler) (flux.Query, error) {
-	q, err := c.createQuery(ctx, compiler.CompilerType())
+	q, err := c.createQuery(ctx, compiler)
 	if err != nil {
 		return nil, err
 	}
@@ -138,40 +325,40 @@ func (c *Controller) Query(ctx context.Context, compiler flux.Compiler) (flux.Qu
 		q.setErr(err)
 		c.finish(q)
 		c.countQueryRequest(q, labelCompileError)
+		c.logQuery(q)
 		return nil, q.Err()
 	}
 	if err := c.enqueueQuery(q); err != nil {
 		q.setErr(err)
 		c.finish(q)
 		c.countQueryRequest(q, labelQueueError)
+		c.logQuery(q)
 		return nil, q.Err()
 	}
 	return q, nil
 }
 
-func (c *Controller) createQuery(ctx context.Context, ct flux.CompilerType) (*Query, error) {
+func (c *Controller) createQuery(ctx context.Context, compiler flux.Compiler) (*Query, error) {
 	c.queriesMu.RLock()
 	if c.shutdown {
 		c.queriesMu.RUnlock()
 		return nil, errors.New("query controller shutdown")
 	}
+	if c.draining {
+		c.queriesMu.RUnlock()
+		return nil, DrainingError{}
+	}
 	c.queriesMu.RUnlock()
 
 	id := c.nextID()
 	labelValues := make([]string, len(c.labelKeys))
 	compileLabelValues := make([]string, len(c.labelKeys)+1)
 	for i, k := range c.labelKeys {
-		value := ctx.Value(k)
-		var str string
-		switch v := value.(type) {
-		case string:
-			str = v
-		case fmt.Stringer:
-			str = v.String()
-		}
+		str := labelValue(ctx, k)
 		labelValues[i] = str
 		compileLabelValues[i] = str
 	}
+	ct := compiler.CompilerType()
 	compileLabelValues[len(compileLabelValues)-1] = string(ct)
 
 	cctx, cancel := context.WithCancel(ctx)
@@ -183,6 +370,12 @@ func (c *Controller) createQuery(ctx context.Context, ct flux.CompilerType) (*Qu
 	)
 	q := &Query{
 		id:                 id,
+		priority:           flux.High,
+		tenant:             labelValue(ctx, c.tenantKey),
+		timeouts:           timeoutsFromContext(ctx, c.timeouts),
+		compilerType:       ct,
+		source:             querySource(compiler),
+		createdAt:          time.Now(),
 		labelValues:        labelValues,
 		compileLabelValues: compileLabelValues,
 		state:              Created,
@@ -197,10 +390,13 @@ func (c *Controller) createQuery(ctx context.Context, ct flux.CompilerType) (*Qu
 	c.queriesMu.Lock()
 	defer c.queriesMu.Unlock()
 
-	if c.shutdown {
-		// Query controller was shutdown between when we started
-		// creating the query and ending it.
-		err := errors.New("query controller shutdown")
+	if c.shutdown || c.draining {
+		// Query controller was shutdown or started draining between
+		// when we started creating the query and ending it.
+		var err error = DrainingError{}
+		if c.shutdown {
+			err = errors.New("query controller shutdown")
+		}
 		q.setErr(err)
 		return nil, err
 	}
@@ -208,6 +404,39 @@ func (c *Controller) createQuery(ctx context.Context, ct flux.CompilerType) (*Qu
 	return q, nil
 }
 
+// labelValue reads the value of a label from the context.
+// The context value must be a string or an implementation of the Stringer interface.
+func labelValue(ctx context.Context, key string) string {
+	if key == "" {
+		return ""
+	}
+	switch v := ctx.Value(key).(type) {
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// querySource returns the Flux source of the query compiled by the compiler,
+// or an empty string if the compiler does not have any.
+func querySource(compiler flux.Compiler) string {
+	switch c := compiler.(type) {
+	case lang.FluxCompiler:
+		return c.Query
+	case *lang.FluxCompiler:
+		return c.Query
+	case lang.ASTCompiler:
+		return ast.Format(c.AST)
+	case *lang.ASTCompiler:
+		return ast.Format(c.AST)
+	default:
+		return ""
+	}
+}
+
 func (c *Controller) nextID() QueryID {
 	nextID := atomic.AddUint64(&c.lastID, 1)
 	return QueryID(nextID)
@@ -238,6 +467,13 @@ func (c *Controller) compileQuery(q *Query, compiler flux.Compiler) error {
 	}
 
 	q.program = prog
+
+	// The priority set on the context takes precedence over the resources of the query.
+	if p, ok := priorityFromContext(ctx); ok {
+		q.priority = p
+	} else if p, ok := priorityFromProgram(prog); ok {
+		q.priority = p
+	}
 	return nil
 }
 
@@ -246,29 +482,58 @@ func (c *Controller) enqueueQuery(q *Query) error {
 		return errors.New("failed to transition query to queueing state")
 	}
 
-	select {
-	case c.queryQueue <- q:
-	default:
-		return errors.New("queue length exceeded")
+	if d := q.timeouts.queue; d > 0 {
+		q.queueTimer = time.AfterFunc(d, func() {
+			c.queueTimeout(q, d)
+		})
 	}
-
+	position, err := c.queryQueue.push(q)
+	if err != nil {
+		q.stopQueueTimer()
+		reason := "queue_full"
+		if err == errTenantQueueFull {
+			reason = "tenant_queue_full"
+		}
+		c.metrics.tenantRejected.WithLabelValues(q.tenant, reason).Inc()
+		return err
+	}
+	c.metrics.queueingPosition.WithLabelValues(q.labelValues...).Observe(float64(position))
+	c.metrics.tenantQueueing.WithLabelValues(q.tenant).Inc()
 	return nil
 }
 
+// queueTimeout fails the query if it is still waiting in the queue.
+func (c *Controller) queueTimeout(q *Query, timeout time.Duration) {
+	if !c.queryQueue.remove(q) {
+		// The query is already executing.
+		return
+	}
+	c.metrics.tenantQueueing.WithLabelValues(q.tenant).Dec()
+	q.setErr(TimeoutError{State: Queueing, Timeout: timeout})
+}
+
 func (c *Controller) processQueryQueue() {
 	for {
-		select {
-		case <-c.done:
+		q := c.queryQueue.pop()
+		if q == nil {
+			// The controller has been shutdown.
 			return
-		case q := <-c.queryQueue:
-			c.executeQuery(q)
 		}
+		c.metrics.tenantQueueing.WithLabelValues(q.tenant).Dec()
+		executing := c.metrics.tenantExecuting.WithLabelValues(q.tenant)
+		executing.Inc()
+		c.executeQuery(q)
+		executing.Dec()
+		c.queryQueue.release(q)
 	}
 }
 
 func (c *Controller) executeQuery(q *Query) {
-	ctx, ok := q.tryExec()
+	q.stopQueueTimer()
+	alloc := c.newAllocator()
+	ctx, ok := q.tryExec(alloc)
 	if !ok {
+		c.releaseMemory(alloc)
 		// This may happen if the query was cancelled (either because the
 		// client cancelled it, or because the controller is shutting down)
 		// In the case of cancellation, SetErr() should reset the error to an
@@ -277,28 +542,84 @@ func (c *Controller) executeQuery(q *Query) {
 		return
 	}
 
-	q.alloc = new(memory.Allocator)
-	q.alloc.Limit = func(v int64) *int64 { return &v }(c.memoryBytesQuotaPerQuery)
-	exec, err := q.program.Start(ctx, q.alloc)
+	if timeout := q.timeouts.execute; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	exec, err := q.program.Start(ctx, alloc)
 	if err != nil {
 		q.addRuntimeError(err)
 		q.setErr(err)
 		return
 	}
 	q.exec = exec
-	q.pump(exec, ctx.Done())
+	q.pump(ctx, exec)
+}
+
+// newAllocator creates the allocator for a query that is about to execute.
+// When the memory pool is enabled, the query has already reserved its initial memory
+// and it must be released with releaseMemory.
+func (c *Controller) newAllocator() *memory.Allocator {
+	if c.memoryPool != nil {
+		return c.memoryPool.allocator()
+	}
+	return &memory.Allocator{
+		Limit: func(v int64) *int64 { return &v }(c.memoryBytesQuotaPerQuery),
+	}
+}
+
+// releaseMemory returns the memory borrowed by the allocator of a query to the memory pool.
+// It is safe to call more than once.
+func (c *Controller) releaseMemory(alloc *memory.Allocator) {
+	if c.memoryPool != nil {
+		c.memoryPool.release(alloc)
+	}
 }
 
 func (c *Controller) finish(q *Query) {
 	c.queriesMu.Lock()
 	delete(c.queries, q.id)
-	if len(c.queries) == 0 && c.shutdown {
-		close(c.done)
+	if len(c.queries) == 0 {
+		if c.draining {
+			c.drainedOnce()
+		}
+		if c.shutdown {
+			c.stop()
+		}
 	}
 	c.queriesMu.Unlock()
 }
 
-// Queries reports the active queries.
+// stop signals that all of the queries are finished and stops the query processing goroutines.
+// It must be called with the queries mutex held.
+func (c *Controller) stop() {
+	c.doneOnce.Do(func() {
+		close(c.done)
+		c.queryQueue.close()
+	})
+}
+
+// drainedOnce signals that all of the queries have finished while the controller is draining.
+// It must be called with the queries mutex held.
+func (c *Controller) drainedOnce() {
+	select {
+	case <-c.drained:
+	default:
+		close(c.drained)
+	}
+}
+
+// setState transitions the controller to the state and reports it in the metrics.
+// It must be called with the queries mutex held, except from New.
+func (c *Controller) setState(state controllerState) {
+	c.metrics.state.WithLabelValues(c.state.String()).Set(0)
+	c.state = state
+	c.metrics.state.WithLabelValues(state.String()).Set(1)
+}
+
+// Queries reports the active queries ordered by their ID.
 func (c *Controller) Queries() []*Query {
 	c.queriesMu.RLock()
 	defer c.queriesMu.RUnlock()
@@ -306,9 +627,56 @@ func (c *Controller) Queries() []*Query {
 	for _, q := range c.queries {
 		queries = append(queries, q)
 	}
+	sort.Slice(queries, func(i, j int) bool {
+		return queries[i].id < queries[j].id
+	})
 	return queries
 }
 
+// Cancel stops the execution of the active query with the ID.
+// The query must still be released with Done by the client that submitted it.
+func (c *Controller) Cancel(id QueryID) error {
+	c.queriesMu.RLock()
+	q, ok := c.queries[id]
+	c.queriesMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("query %d not found", id)
+	}
+	q.Cancel()
+	return nil
+}
+
+// Drain will signal to the Controller that it should not accept any new
+// queries, but that it should continue to execute the queued and executing queries.
+// Queries submitted while the Controller is draining fail with a DrainingError,
+// so they can be retried with another Controller.
+// Once all of the queries have finished, the Controller is shutdown.
+// If the Context is done before that, the remaining queries are canceled
+// as if Shutdown had been called and the Context error is returned.
+func (c *Controller) Drain(ctx context.Context) error {
+	c.queriesMu.Lock()
+	if c.shutdown {
+		c.queriesMu.Unlock()
+		return errors.New("query controller shutdown")
+	}
+	if !c.draining {
+		c.draining = true
+		c.setState(controllerDraining)
+		if len(c.queries) == 0 {
+			c.drainedOnce()
+		}
+	}
+	c.queriesMu.Unlock()
+
+	select {
+	case <-c.drained:
+		return c.Shutdown(ctx)
+	case <-ctx.Done():
+		c.logger.Info("Query controller drain deadline reached, canceling the remaining queries")
+		return c.Shutdown(ctx)
+	}
+}
+
 // Shutdown will signal to the Controller that it should not accept any
 // new queries and that it should finish executing any existing queries.
 // This will return once the Controller's run loop has been exited and all
@@ -318,8 +686,13 @@ func (c *Controller) Shutdown(ctx context.Context) error {
 	// accept new queries.
 	c.queriesMu.Lock()
 	c.shutdown = true
+	if c.state != controllerShutdown {
+		c.setState(controllerShutdown)
+	}
 	if len(c.queries) == 0 {
+		c.stop()
 		c.queriesMu.Unlock()
+		c.wg.Wait()
 		return nil
 	}
 	c.queriesMu.Unlock()
@@ -352,9 +725,58 @@ func (c *Controller) PrometheusCollectors() []prometheus.Collector {
 	return c.metrics.PrometheusCollectors()
 }
 
+// DrainingError is the error of a query that was submitted while the Controller is draining.
+// The query was not executed, so it can be retried with another Controller.
+type DrainingError struct{}
+
+func (DrainingError) Error() string {
+	return "query controller is draining"
+}
+
+// Retriable reports that the query can be retried.
+func (DrainingError) Retriable() bool {
+	return true
+}
+
+// controllerState is the state of the Controller.
+type controllerState int
+
+const (
+	// controllerRunning indicates that the Controller accepts new queries.
+	controllerRunning controllerState = iota
+
+	// controllerDraining indicates that the Controller rejects new queries,
+	// but the queued and executing queries are allowed to finish.
+	controllerDraining
+
+	// controllerShutdown indicates that the Controller rejects new queries
+	// and the active queries have been canceled.
+	controllerShutdown
+)
+
+func (s controllerState) String() string {
+	switch s {
+	case controllerRunning:
+		return "running"
+	case controllerDraining:
+		return "draining"
+	case controllerShutdown:
+		return "shutdown"
+	default:
+		return "unknown"
+	}
+}
+
 // Query represents a single request.
 type Query struct {
-	id QueryID
+	id           QueryID
+	priority     flux.Priority
+	tenant       string
+	timeouts     timeouts
+	queueTimer   *time.Timer
+	compilerType flux.CompilerType
+	source       string
+	createdAt    time.Time
 
 	labelValues        []string
 	compileLabelValues []string
@@ -377,6 +799,7 @@ type Query struct {
 	program flux.Program
 	exec    flux.Query
 	results chan flux.Result
+	rows    resultRows
 	alloc   *memory.Allocator
 }
 
@@ -385,6 +808,57 @@ func (q *Query) ID() QueryID {
 	return q.id
 }
 
+// Priority reports the priority the query was queued with.
+func (q *Query) Priority() flux.Priority {
+	return q.priority
+}
+
+// CompilerType reports the type of the compiler that compiled the query.
+func (q *Query) CompilerType() flux.CompilerType {
+	return q.compilerType
+}
+
+// Source reports the Flux source of the query.
+// It is empty if the compiler of the query does not have any source.
+func (q *Query) Source() string {
+	return q.source
+}
+
+// Labels reports the values of the MetricLabelKeys of the controller for the query.
+func (q *Query) Labels() map[string]string {
+	labels := make(map[string]string, len(q.labelValues))
+	for i, k := range q.c.labelKeys {
+		labels[k] = q.labelValues[i]
+	}
+	return labels
+}
+
+// Elapsed reports the time since the query was submitted.
+func (q *Query) Elapsed() time.Duration {
+	return time.Since(q.createdAt)
+}
+
+// Allocated reports the number of bytes currently allocated by the query.
+// It is zero until the query starts executing.
+func (q *Query) Allocated() int64 {
+	if alloc := q.allocator(); alloc != nil {
+		return alloc.Allocated()
+	}
+	return 0
+}
+
+// allocator returns the allocator of the query or nil if it has not started executing.
+func (q *Query) allocator() *memory.Allocator {
+	q.stateMu.RLock()
+	defer q.stateMu.RUnlock()
+	return q.alloc
+}
+
+// Tenant reports the tenant the query belongs to.
+func (q *Query) Tenant() string {
+	return q.tenant
+}
+
 // Cancel will stop the query execution.
 func (q *Query) Cancel() {
 	// Call the cancel function to signal that execution should
@@ -418,24 +892,37 @@ func (q *Query) Done() {
 				q.err = q.exec.Err()
 			}
 			stats := q.exec.Statistics()
+			q.stats.PlanDuration = stats.PlanDuration
 			q.stats.Metadata = stats.Metadata
+			q.stats.Nodes = stats.Nodes
+			q.stats.RuntimeErrors = stats.RuntimeErrors
+		}
+		// The query has finished executing, so return its memory to the pool.
+		if alloc := q.allocator(); alloc != nil {
+			q.c.releaseMemory(alloc)
 		}
 
-		errMsgs := make([]string, 0, len(q.runtimeErrs))
+		// The errors read from the results are usually the errors of the nodes
+		// that have already been reported by the program, so only add the others.
+		reported := nodeErrors(q.err)
 		for _, e := range q.runtimeErrs {
-			errMsgs = append(errMsgs, e.Error())
+			if !reported[e.Error()] {
+				q.stats.RuntimeErrors = append(q.stats.RuntimeErrors, e.Error())
+			}
 		}
-		q.stats.RuntimeErrors = errMsgs
 
 		q.transitionTo(Finished)
 		q.c.finish(q)
 
 		// count query request
-		if q.err != nil || len(q.runtimeErrs) > 0 {
+		if _, ok := q.err.(TimeoutError); ok {
+			q.c.countQueryRequest(q, labelTimeout)
+		} else if q.err != nil || len(q.runtimeErrs) > 0 {
 			q.c.countQueryRequest(q, labelRuntimeError)
 		} else {
 			q.c.countQueryRequest(q, labelSuccess)
 		}
+		q.c.logQuery(q)
 	})
 }
 
@@ -445,8 +932,8 @@ func (q *Query) Done() {
 // the query has been finalized unless a context is given.
 func (q *Query) Statistics() flux.Statistics {
 	stats := q.stats
-	if q.alloc != nil {
-		stats.MaxAllocated = q.alloc.MaxAllocated()
+	if alloc := q.allocator(); alloc != nil {
+		stats.MaxAllocated = alloc.MaxAllocated()
 	}
 	return stats
 }
@@ -599,6 +1086,48 @@ func (q *Query) setErr(err error) {
 	close(q.results)
 }
 
+// setTimeoutErr marks this query with the error of a timeout
+// during execution and transitions it to the errored state.
+//
+// Unlike setErr, it does not close the results channel,
+// which is closed once the results have been pumped.
+func (q *Query) setTimeoutErr(err TimeoutError) {
+	q.stateMu.Lock()
+	defer q.stateMu.Unlock()
+
+	if isFinishedState(q.state) {
+		return
+	}
+	q.transitionTo(Errored)
+	q.err = err
+}
+
+// stopQueueTimer stops the timer that fails the query when it has been queued for too long.
+func (q *Query) stopQueueTimer() {
+	if q.queueTimer != nil {
+		q.queueTimer.Stop()
+	}
+}
+
+// nodeErrors returns the messages of the errors produced by the nodes of the plan
+// that are reported by the error.
+func nodeErrors(err error) map[string]bool {
+	reported := make(map[string]bool)
+	var errs []error
+	switch err := err.(type) {
+	case execute.MultiError:
+		errs = err
+	case *execute.NodeError:
+		errs = []error{err}
+	}
+	for _, err := range errs {
+		if ne, ok := err.(*execute.NodeError); ok {
+			reported[ne.Err.Error()] = true
+		}
+	}
+	return reported
+}
+
 func (q *Query) addRuntimeError(e error) {
 	q.stateMu.Lock()
 	defer q.stateMu.Unlock()
@@ -610,8 +1139,18 @@ func (q *Query) addRuntimeError(e error) {
 // results to our destination.
 // When there are no more results, then this will close our own
 // results channel.
-func (q *Query) pump(exec flux.Query, done <-chan struct{}) {
-	defer close(q.results)
+func (q *Query) pump(ctx context.Context, exec flux.Query) {
+	defer func() {
+		// The query context is also canceled when the client cancels the query,
+		// so only report a timeout when the parent context is still active.
+		// The error must be set before the results channel is closed,
+		// because the client may call Done as soon as it is.
+		if ctx.Err() == context.DeadlineExceeded && q.parentCtx.Err() == nil {
+			q.setTimeoutErr(TimeoutError{State: Executing, Timeout: q.timeouts.execute})
+		}
+		close(q.results)
+	}()
+	done := ctx.Done()
 
 	// When our context is canceled, we need to propagate that cancel
 	// signal down to the executing program just in case it is waiting
@@ -633,6 +1172,7 @@ func (q *Query) pump(exec flux.Query, done <-chan struct{}) {
 			// case, but if the query has been canceled or finished with
 			// done, nobody is going to read these values so we need
 			// to avoid blocking.
+			q.rows.add(res.Name(), 0)
 			ecr := &errorCollectingResult{
 				Result: res,
 				q:      q,
@@ -675,11 +1215,16 @@ func (q *Query) tryQueue() (context.Context, bool) {
 }
 
 // tryExec attempts to transition the query into the Executing state.
-func (q *Query) tryExec() (context.Context, bool) {
+// The query uses the allocator if the transition succeeds.
+func (q *Query) tryExec(alloc *memory.Allocator) (context.Context, bool) {
 	q.stateMu.Lock()
 	defer q.stateMu.Unlock()
 
-	return q.transitionTo(Executing, Queueing)
+	ctx, ok := q.transitionTo(Executing, Queueing)
+	if ok {
+		q.alloc = alloc
+	}
+	return ctx, ok
 }
 
 type errorCollectingResult struct {
@@ -690,17 +1235,25 @@ type errorCollectingResult struct {
 func (r *errorCollectingResult) Tables() flux.TableIterator {
 	return &errorCollectingTableIterator{
 		TableIterator: r.Result.Tables(),
+		name:          r.Name(),
 		q:             r.q,
 	}
 }
 
 type errorCollectingTableIterator struct {
 	flux.TableIterator
-	q *Query
+	name string
+	q    *Query
 }
 
 func (ti *errorCollectingTableIterator) Do(f func(t flux.Table) error) error {
-	err := ti.TableIterator.Do(f)
+	err := ti.TableIterator.Do(func(t flux.Table) error {
+		return f(&rowCountingTable{
+			Table: t,
+			name:  ti.name,
+			rows:  &ti.q.rows,
+		})
+	})
 	if err != nil {
 		ti.q.addRuntimeError(err)
 	}
@@ -719,9 +1272,7 @@ const (
 	Compiling
 
 	// Queueing indicates the query is waiting inside of the
-	// scheduler to be executed.
-	// TODO(jsternberg): This stage isn't used currently, but
-	// it makes sense to readd this once we have a work queue again.
+	// priority queue to be executed.
 	Queueing
 
 	// Executing indicates that the query is currently executing.
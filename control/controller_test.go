@@ -2,12 +2,19 @@ package control_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin"
 	"github.com/influxdata/flux/control"
@@ -21,7 +28,10 @@ import (
 	"github.com/influxdata/flux/stdlib/universe"
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func init() {
@@ -455,6 +465,87 @@ func TestController_LimitExceededError(t *testing.T) {
 	}
 }
 
+func TestController_MultipleRuntimeErrors(t *testing.T) {
+	const memoryBytesQuotaPerQuery = 64
+	config := config
+	config.MemoryBytesQuotaPerQuery = memoryBytesQuotaPerQuery
+	ctrl, err := control.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shutdown(t, ctrl)
+
+	compiler := &mock.Compiler{
+		CompileFn: func(ctx context.Context) (flux.Program, error) {
+			// Return a program with two results that each allocate more than is allowed.
+			pts := plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("allocating-from-a", &executetest.AllocatingFromProcedureSpec{
+						ByteCount: memoryBytesQuotaPerQuery + 1,
+					}),
+					plan.CreatePhysicalNode("yield-a", &universe.YieldProcedureSpec{Name: "a"}),
+					plan.CreatePhysicalNode("allocating-from-b", &executetest.AllocatingFromProcedureSpec{
+						ByteCount: memoryBytesQuotaPerQuery + 1,
+					}),
+					plan.CreatePhysicalNode("yield-b", &universe.YieldProcedureSpec{Name: "b"}),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{2, 3},
+				},
+				Resources: flux.ResourceManagement{
+					ConcurrencyQuota: 1,
+				},
+			}
+
+			ps := plantest.CreatePlanSpec(&pts)
+			prog := &lang.Program{
+				Logger:   zaptest.NewLogger(t),
+				PlanSpec: ps,
+			}
+
+			return prog, nil
+		},
+	}
+
+	q, err := ctrl.Query(context.Background(), compiler)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(t flux.Table) error {
+			return nil
+		}); err == nil {
+			t.Errorf("expected an error from result %s", res.Name())
+		}
+	}
+	q.Done()
+
+	errs, ok := q.Err().(execute.MultiError)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("expected an error for each source, got %v", q.Err())
+	}
+	got := make(map[plan.NodeID]bool)
+	for _, err := range errs {
+		if ne, ok := err.(*execute.NodeError); ok {
+			got[ne.NodeID] = true
+		}
+	}
+	if want := map[plan.NodeID]bool{"allocating-from-a": true, "allocating-from-b": true}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected node errors -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	stats := q.Statistics()
+	if len(stats.RuntimeErrors) != 2 {
+		t.Fatalf("expected two runtime errors reported in stats, got %v", stats.RuntimeErrors)
+	}
+	for _, msg := range stats.RuntimeErrors {
+		if !strings.Contains(msg, "memory") {
+			t.Errorf("expected an error about memory limit exceeded, got %s", msg)
+		}
+	}
+}
+
 func TestController_ShutdownWithRunningQuery(t *testing.T) {
 	ctrl, err := control.New(config)
 	if err != nil {
@@ -587,6 +678,654 @@ func TestController_PerQueryMemoryLimit(t *testing.T) {
 	}
 }
 
+func TestController_MemoryPool(t *testing.T) {
+	config := config
+	config.ConcurrencyQuota = 2
+	config.QueueSize = 2
+	config.MaxMemoryBytes = config.MemoryBytesQuotaPerQuery
+	config.InitialMemoryBytesQuotaPerQuery = config.MemoryBytesQuotaPerQuery / 2
+	ctrl, err := control.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shutdown(t, ctrl)
+	reg := setupPromRegistry(ctrl)
+
+	// The first query grows beyond its initial memory while the pool has room for it.
+	allocated, unblock := make(chan struct{}), make(chan struct{})
+	large, err := ctrl.Query(context.Background(), &mock.Compiler{
+		CompileFn: func(ctx context.Context) (flux.Program, error) {
+			return &mock.Program{
+				ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+					err := alloc.Allocate(int(config.MemoryBytesQuotaPerQuery))
+					close(allocated)
+					if err != nil {
+						q.SetErr(err)
+						return
+					}
+					<-unblock
+					alloc.Free(int(config.MemoryBytesQuotaPerQuery))
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-allocated
+
+	// The second query waits for memory even though a worker is free.
+	executing := make(chan struct{})
+	small, err := ctrl.Query(context.Background(), &mock.Compiler{
+		CompileFn: func(ctx context.Context) (flux.Program, error) {
+			return &mock.Program{
+				ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+					close(executing)
+					if err := alloc.Allocate(64); err != nil {
+						q.SetErr(err)
+					}
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-executing:
+		t.Fatal("query executed without memory in the pool")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(unblock)
+	consumeResults(t, large)
+	if got, want := large.Statistics().MaxAllocated, config.MemoryBytesQuotaPerQuery; got != want {
+		t.Errorf("unexpected max allocated: got %d want %d", got, want)
+	}
+	consumeResults(t, small)
+
+	// A query cannot borrow more than the memory quota of a single query.
+	q, err := ctrl.Query(context.Background(), &mock.Compiler{
+		CompileFn: func(ctx context.Context) (flux.Program, error) {
+			return &mock.Program{
+				ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+					if err := alloc.Allocate(int(config.MemoryBytesQuotaPerQuery + 1)); err != nil {
+						q.SetErr(err)
+					}
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range q.Results() {
+		// discard the results
+	}
+	q.Done()
+	if q.Err() == nil {
+		t.Fatal("expected error about memory limit exceeded")
+	}
+
+	// All of the memory has been returned to the pool.
+	metrics, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := FindMetric(metrics, "query_control_memory_unused_bytes", nil)
+	if m == nil {
+		t.Fatal("missing memory unused metric")
+	}
+	if got, want := m.Gauge.GetValue(), float64(config.MaxMemoryBytes); got != want {
+		t.Errorf("unexpected unused memory: got %v want %v", got, want)
+	}
+}
+
+func TestController_InvalidMemoryPool(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		max     int64
+		initial int64
+	}{
+		{name: "initial without pool", initial: 1},
+		{name: "initial greater than quota", max: 4096, initial: config.MemoryBytesQuotaPerQuery + 1},
+		{name: "pool smaller than initial", max: 256, initial: 512},
+		{name: "pool smaller than quota", max: config.MemoryBytesQuotaPerQuery - 1},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			config := config
+			config.MaxMemoryBytes = tc.max
+			config.InitialMemoryBytesQuotaPerQuery = tc.initial
+			if _, err := control.New(config); err == nil {
+				t.Fatal("expected an invalid config error")
+			}
+		})
+	}
+}
+
+func TestController_QueriesAndCancel(t *testing.T) {
+	config := config
+	config.ConcurrencyQuota = 2
+	config.MetricLabelKeys = []string{"org"}
+	ctrl, err := control.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shutdown(t, ctrl)
+
+	ctx := context.WithValue(context.Background(), "org", "myorg")
+	executing := make(chan struct{})
+	running, err := ctrl.Query(ctx, &mock.Compiler{
+		CompileFn: func(ctx context.Context) (flux.Program, error) {
+			return &mock.Program{
+				ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+					if err := alloc.Allocate(64); err != nil {
+						q.SetErr(err)
+						return
+					}
+					close(executing)
+					// Run until the query is canceled.
+					<-ctx.Done()
+					alloc.Free(64)
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-executing
+
+	const source = `x = 1`
+	compiled, err := ctrl.Query(ctx, lang.FluxCompiler{Query: source})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queries := ctrl.Queries()
+	if len(queries) != 2 {
+		t.Fatalf("unexpected number of queries: %d", len(queries))
+	}
+	q := queries[0]
+	if q.ID() != running.(*control.Query).ID() {
+		t.Fatalf("unexpected query order, first query has ID %d", q.ID())
+	}
+	if got, want := q.State(), control.Executing; got != want {
+		t.Errorf("unexpected state: got %v want %v", got, want)
+	}
+	if got, want := q.CompilerType(), mockCompiler.CompilerType(); got != want {
+		t.Errorf("unexpected compiler type: got %v want %v", got, want)
+	}
+	if got, want := q.Labels(), map[string]string{"org": "myorg"}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected labels -want/+got\n%s", cmp.Diff(want, got))
+	}
+	if q.Elapsed() <= 0 {
+		t.Error("expected elapsed time to be above zero")
+	}
+	if got, want := q.Allocated(), int64(64); got != want {
+		t.Errorf("unexpected allocated memory: got %d want %d", got, want)
+	}
+	if got := q.Source(); got != "" {
+		t.Errorf("unexpected source for a mock compiler: %q", got)
+	}
+	if got, want := queries[1].Source(), source; got != want {
+		t.Errorf("unexpected source: got %q want %q", got, want)
+	}
+	if got, want := queries[1].CompilerType(), flux.CompilerType(lang.FluxCompilerType); got != want {
+		t.Errorf("unexpected compiler type: got %v want %v", got, want)
+	}
+	for range compiled.Results() {
+	}
+	compiled.Done()
+
+	if err := ctrl.Cancel(q.ID()); err != nil {
+		t.Fatal(err)
+	}
+	for range running.Results() {
+		// discard the results
+	}
+	if got, want := q.State(), control.Canceled; got != want {
+		t.Errorf("unexpected state after cancel: got %v want %v", got, want)
+	}
+	running.Done()
+	if n := len(ctrl.Queries()); n != 0 {
+		t.Errorf("expected no active queries, found %d", n)
+	}
+	if err := ctrl.Cancel(q.ID()); err == nil {
+		t.Error("expected an error when canceling an unknown query")
+	}
+}
+
+func TestController_Timeouts(t *testing.T) {
+	config := config
+	config.QueueSize = 2
+	config.QueueTimeout = 10 * time.Millisecond
+	ctrl, err := control.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shutdown(t, ctrl)
+	reg := setupPromRegistry(ctrl)
+
+	// The query executes until it is canceled.
+	compiler := func(executing chan struct{}) flux.Compiler {
+		return &mock.Compiler{
+			CompileFn: func(ctx context.Context) (flux.Program, error) {
+				return &mock.Program{
+					ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+						close(executing)
+						<-ctx.Done()
+					},
+				}, nil
+			},
+		}
+	}
+
+	// The query exceeds the execute timeout set on its context.
+	executing := make(chan struct{})
+	ctx := control.WithTimeouts(context.Background(), 0, 50*time.Millisecond)
+	running, err := ctrl.Query(ctx, compiler(executing))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-executing
+
+	// The only worker is busy, so the query exceeds the default queue timeout.
+	queued, err := ctrl.Query(context.Background(), compiler(make(chan struct{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range queued.Results() {
+		// discard the results
+	}
+	queued.Done()
+	want := control.TimeoutError{State: control.Queueing, Timeout: config.QueueTimeout}
+	if got := queued.Err(); got != want {
+		t.Errorf("unexpected queue error: got %v want %v", got, want)
+	}
+
+	for range running.Results() {
+		// discard the results
+	}
+	running.Done()
+	want = control.TimeoutError{State: control.Executing, Timeout: 50 * time.Millisecond}
+	if got := running.Err(); got != want {
+		t.Errorf("unexpected execute error: got %v want %v", got, want)
+	}
+
+	metrics, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := FindMetric(metrics, "query_control_requests_total", map[string]string{"result": "timeout"})
+	if m == nil {
+		t.Fatal("missing timeout requests metric")
+	}
+	if got := m.Counter.GetValue(); got != 2 {
+		t.Errorf("unexpected timeout requests: %v", got)
+	}
+	if n := len(ctrl.Queries()); n != 0 {
+		t.Errorf("expected no active queries, found %d", n)
+	}
+}
+
+func TestController_Drain(t *testing.T) {
+	// compiler creates queries that execute until they are released or canceled.
+	// The canceled channel, if any, is closed when the query is canceled.
+	compiler := func(executing chan<- struct{}, release <-chan struct{}, canceled chan<- struct{}) flux.Compiler {
+		return &mock.Compiler{
+			CompileFn: func(ctx context.Context) (flux.Program, error) {
+				return &mock.Program{
+					ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+						executing <- struct{}{}
+						select {
+						case <-release:
+							q.ResultsCh <- &executetest.Result{}
+						case <-ctx.Done():
+							if canceled != nil {
+								close(canceled)
+							}
+						}
+					},
+				}, nil
+			},
+		}
+	}
+	// consume reads the results of the query and marks it as done in the background.
+	consume := func(q flux.Query) <-chan struct{} {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for range q.Results() {
+				// discard the results
+			}
+			q.Done()
+		}()
+		return done
+	}
+	state := func(t *testing.T, reg *prometheus.Registry) string {
+		t.Helper()
+		metrics, err := reg.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, state := range []string{"running", "draining", "shutdown"} {
+			m := FindMetric(metrics, "query_control_state", map[string]string{"state": state})
+			if m != nil && m.Gauge.GetValue() == 1 {
+				return state
+			}
+		}
+		return ""
+	}
+
+	t.Run("finish", func(t *testing.T) {
+		config := config
+		config.QueueSize = 2
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+		reg := setupPromRegistry(ctrl)
+		if got := state(t, reg); got != "running" {
+			t.Fatalf("unexpected state: %s", got)
+		}
+
+		executing := make(chan struct{}, 2)
+		release := make(chan struct{})
+		running, err := ctrl.Query(context.Background(), compiler(executing, release, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		<-executing
+		queued, err := ctrl.Query(context.Background(), compiler(executing, release, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		runningDone, queuedDone := consume(running), consume(queued)
+
+		drained := make(chan error, 1)
+		go func() {
+			drained <- ctrl.Drain(context.Background())
+		}()
+		// Wait for the controller to start draining.
+		for state(t, reg) != "draining" {
+			time.Sleep(time.Millisecond)
+		}
+
+		_, err = ctrl.Query(context.Background(), mockCompiler)
+		if derr, ok := err.(control.DrainingError); !ok || !derr.Retriable() {
+			t.Fatalf("expected a retriable draining error, got %v", err)
+		}
+
+		// The queued query is executed once the running query finishes.
+		close(release)
+		<-executing
+		if err := <-drained; err != nil {
+			t.Fatal(err)
+		}
+		<-runningDone
+		<-queuedDone
+		for _, q := range []flux.Query{running, queued} {
+			if err := q.Err(); err != nil {
+				t.Errorf("unexpected query error: %s", err)
+			}
+		}
+		if got := state(t, reg); got != "shutdown" {
+			t.Errorf("unexpected state: %s", got)
+		}
+		if _, err := ctrl.Query(context.Background(), mockCompiler); err == nil {
+			t.Error("expected an error for a query after the controller drained")
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+		reg := setupPromRegistry(ctrl)
+
+		executing := make(chan struct{}, 1)
+		canceled := make(chan struct{})
+		q, err := ctrl.Query(context.Background(), compiler(executing, nil, canceled))
+		if err != nil {
+			t.Fatal(err)
+		}
+		<-executing
+		done := consume(q)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if err := ctrl.Drain(ctx); err != context.DeadlineExceeded {
+			t.Errorf("unexpected drain error: %v", err)
+		}
+		<-done
+		select {
+		case <-canceled:
+		case <-time.After(time.Second):
+			t.Error("expected the query to be canceled")
+		}
+		if got := state(t, reg); got != "shutdown" {
+			t.Errorf("unexpected state: %s", got)
+		}
+	})
+}
+
+// queryLog records the entries of the finished queries.
+type queryLog struct {
+	mu      sync.Mutex
+	entries []control.QueryLogEntry
+}
+
+func (l *queryLog) LogQuery(entry control.QueryLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+func TestController_QueryLog(t *testing.T) {
+	// compiler creates queries that execute for the duration
+	// and return a single table with three rows.
+	compiler := func(d time.Duration) flux.Compiler {
+		return &mock.Compiler{
+			CompileFn: func(ctx context.Context) (flux.Program, error) {
+				return &mock.Program{
+					ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+						time.Sleep(d)
+						q.ResultsCh <- &executetest.Result{
+							Nm: "_result",
+							Tbls: []*executetest.Table{{
+								ColMeta: []flux.ColMeta{
+									{Label: "_value", Type: flux.TFloat},
+								},
+								Data: [][]interface{}{{1.0}, {2.0}, {3.0}},
+							}},
+						}
+					},
+				}, nil
+			},
+		}
+	}
+	const threshold = 20 * time.Millisecond
+
+	t.Run("sink", func(t *testing.T) {
+		log := &queryLog{}
+		config := config
+		config.MetricLabelKeys = []string{"org"}
+		config.SlowQueryThreshold = threshold
+		config.QueryLogger = log
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+
+		ctx := context.WithValue(context.Background(), "org", "a")
+		for _, d := range []time.Duration{0, 2 * threshold} {
+			q, err := ctrl.Query(ctx, compiler(d))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Only the rows that are read are counted.
+			for res := range q.Results() {
+				if err := res.Tables().Do(func(tbl flux.Table) error {
+					return tbl.Do(func(flux.ColReader) error { return nil })
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			q.Done()
+		}
+		// The queries that fail are logged with their error.
+		source := `x = `
+		q, err := ctrl.Query(ctx, lang.FluxCompiler{Query: source})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range q.Results() {
+			// discard the results
+		}
+		q.Done()
+		if _, err := ctrl.Query(ctx, &mock.Compiler{
+			CompileFn: func(ctx context.Context) (flux.Program, error) {
+				return nil, errors.New("expected")
+			},
+		}); err == nil {
+			t.Fatal("expected a compile error")
+		}
+
+		if len(log.entries) != 4 {
+			t.Fatalf("unexpected number of entries: %d", len(log.entries))
+		}
+		for i, entry := range log.entries {
+			if got, want := entry.Labels, map[string]string{"org": "a"}; !cmp.Equal(want, got) {
+				t.Errorf("unexpected labels of entry %d -want/+got\n%s", i, cmp.Diff(want, got))
+			}
+			if entry.Statistics.TotalDuration <= 0 {
+				t.Errorf("unexpected total duration of entry %d: %v", i, entry.Statistics.TotalDuration)
+			}
+		}
+		for i, entry := range log.entries[:2] {
+			if entry.Err != nil {
+				t.Errorf("unexpected error of entry %d: %s", i, entry.Err)
+			}
+			if got, want := entry.ResultRows, map[string]int64{"_result": 3}; !cmp.Equal(want, got) {
+				t.Errorf("unexpected result rows of entry %d -want/+got\n%s", i, cmp.Diff(want, got))
+			}
+			if entry.Statistics.ExecuteDuration <= 0 {
+				t.Errorf("unexpected execute duration of entry %d: %v", i, entry.Statistics.ExecuteDuration)
+			}
+		}
+		if log.entries[0].Slow || !log.entries[1].Slow {
+			t.Errorf("unexpected slow queries: %v %v", log.entries[0].Slow, log.entries[1].Slow)
+		}
+		for i, entry := range log.entries[2:] {
+			if entry.Err == nil {
+				t.Errorf("expected an error for entry %d", i+2)
+			}
+		}
+		sum := sha256.Sum256([]byte(source))
+		if want := hex.EncodeToString(sum[:]); log.entries[2].SourceHash != want {
+			t.Errorf("unexpected source hash: got %s want %s", log.entries[2].SourceHash, want)
+		}
+	})
+
+	t.Run("logger", func(t *testing.T) {
+		core, logs := observer.New(zap.DebugLevel)
+		config := config
+		config.SlowQueryThreshold = threshold
+		config.Logger = zap.New(core)
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+
+		for _, d := range []time.Duration{0, 2 * threshold} {
+			q, err := ctrl.Query(context.Background(), compiler(d))
+			if err != nil {
+				t.Fatal(err)
+			}
+			consumeResults(t, q)
+		}
+		var levels []zapcore.Level
+		for _, entry := range logs.All() {
+			if entry.Message == "Query finished" || entry.Message == "Slow query finished" {
+				levels = append(levels, entry.Level)
+			}
+		}
+		if want := []zapcore.Level{zap.DebugLevel, zap.InfoLevel}; !cmp.Equal(want, levels) {
+			t.Errorf("unexpected log levels -want/+got\n%s", cmp.Diff(want, levels))
+		}
+	})
+}
+
+// dependenciesProgram records the executor dependencies given to it by the controller.
+type dependenciesProgram struct {
+	mock.Program
+	deps execute.Dependencies
+}
+
+func (p *dependenciesProgram) SetExecutorDependencies(deps execute.Dependencies) {
+	p.deps = deps
+}
+
+func (p *dependenciesProgram) SetLogger(logger *zap.Logger) {}
+
+func TestController_SpillDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "controller_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(file, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, spillDir := range []string{filepath.Join(dir, "missing"), file} {
+		config := config
+		config.SpillDirectory = spillDir
+		if _, err := control.New(config); err == nil {
+			t.Errorf("expected an error for spill directory %q", spillDir)
+		}
+	}
+
+	config := config
+	config.SpillDirectory = dir
+	config.ExecutorDependencies = execute.Dependencies{}
+	ctrl, err := control.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shutdown(t, ctrl)
+
+	program := &dependenciesProgram{}
+	compiler := &mock.Compiler{
+		CompileFn: func(ctx context.Context) (flux.Program, error) {
+			return program, nil
+		},
+	}
+	q, err := ctrl.Query(context.Background(), compiler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range q.Results() {
+		// discard the results
+	}
+	q.Done()
+
+	if got := execute.GetSpillConfig(program.deps); got == nil || got.Dir != dir {
+		t.Errorf("unexpected spill config: %v", got)
+	}
+	if _, ok := config.ExecutorDependencies[execute.SpillConfigKey]; ok {
+		t.Error("controller modified the executor dependencies of the config")
+	}
+}
+
 func TestController_ConcurrencyQuota(t *testing.T) {
 	const (
 		numQueries       = 3
@@ -723,6 +1462,462 @@ func TestController_QueueSize(t *testing.T) {
 	}
 }
 
+func TestController_Priority(t *testing.T) {
+	type queued struct {
+		name     string
+		priority flux.Priority
+		// wait is the time to wait before queueing the query.
+		wait time.Duration
+	}
+	for _, tc := range []struct {
+		name      string
+		aging     time.Duration
+		queries   []queued
+		want      []string
+		positions float64
+	}{
+		{
+			name: "by priority",
+			queries: []queued{
+				{name: "batch", priority: 10},
+				{name: "report", priority: 5},
+				{name: "dashboard", priority: flux.High},
+				{name: "alert", priority: 5},
+			},
+			want: []string{"dashboard", "report", "alert", "batch"},
+			// The alert query is queued behind the dashboard and report queries.
+			positions: 2,
+		},
+		{
+			name:  "aging",
+			aging: 10 * time.Millisecond,
+			queries: []queued{
+				{name: "batch", priority: 5},
+				{name: "dashboard", priority: 1, wait: 100 * time.Millisecond},
+			},
+			want: []string{"batch", "dashboard"},
+			// The batch query has waited long enough to stay ahead of the dashboard query.
+			positions: 1,
+		},
+		{
+			name:  "aging low priority",
+			aging: time.Millisecond,
+			queries: []queued{
+				{name: "batch", priority: flux.Low},
+				{name: "dashboard", priority: flux.High, wait: 200 * time.Millisecond},
+			},
+			want: []string{"batch", "dashboard"},
+			// The batch query ages as if its priority was 100 and stays ahead of the dashboard query.
+			positions: 1,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			config := config
+			config.QueueSize = len(tc.queries)
+			config.PriorityAgingInterval = tc.aging
+			ctrl, err := control.New(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer shutdown(t, ctrl)
+			reg := setupPromRegistry(ctrl)
+
+			// Block the only worker until every query has been queued.
+			executing, unblock := make(chan struct{}), make(chan struct{})
+			blocker, err := ctrl.Query(context.Background(), &mock.Compiler{
+				CompileFn: func(ctx context.Context) (flux.Program, error) {
+					return &mock.Program{
+						ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+							close(executing)
+							<-unblock
+						},
+					}, nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			<-executing
+
+			var (
+				mu  sync.Mutex
+				got []string
+			)
+			queries := make([]flux.Query, 0, len(tc.queries))
+			for _, qd := range tc.queries {
+				time.Sleep(qd.wait)
+				name := qd.name
+				ctx := control.WithPriority(context.Background(), qd.priority)
+				q, err := ctrl.Query(ctx, &mock.Compiler{
+					CompileFn: func(ctx context.Context) (flux.Program, error) {
+						return &mock.Program{
+							ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+								mu.Lock()
+								got = append(got, name)
+								mu.Unlock()
+							},
+						}, nil
+					},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p := q.(*control.Query).Priority(); p != qd.priority {
+					t.Errorf("unexpected priority for %s: got %d want %d", name, p, qd.priority)
+				}
+				queries = append(queries, q)
+			}
+			close(unblock)
+			consumeResults(t, blocker)
+			for _, q := range queries {
+				consumeResults(t, q)
+			}
+
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected execution order -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+
+			metrics, err := reg.Gather()
+			if err != nil {
+				t.Fatal(err)
+			}
+			m := FindMetric(metrics, "query_control_queueing_position", nil)
+			if m == nil {
+				t.Fatal("missing queueing position metric")
+			}
+			if got, want := m.Histogram.GetSampleCount(), uint64(len(tc.queries)+1); got != want {
+				t.Errorf("unexpected queueing position count: got %d want %d", got, want)
+			}
+			if got, want := m.Histogram.GetSampleSum(), tc.positions; got != want {
+				t.Errorf("unexpected queueing position sum: got %v want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestController_PriorityFromResources(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		want flux.Priority
+	}{
+		{
+			name: "resources",
+			ctx:  context.Background(),
+			want: flux.Low,
+		},
+		{
+			name: "context",
+			ctx:  control.WithPriority(context.Background(), 5),
+			want: 5,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, err := control.New(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer shutdown(t, ctrl)
+
+			q, err := ctrl.Query(tc.ctx, &mock.Compiler{
+				CompileFn: func(ctx context.Context) (flux.Program, error) {
+					ps := plantest.CreatePlanSpec(&plantest.PlanSpec{
+						Nodes: []plan.Node{
+							plan.CreatePhysicalNode("allocating-from-test", &executetest.AllocatingFromProcedureSpec{}),
+							plan.CreatePhysicalNode("yield", &universe.YieldProcedureSpec{Name: "_result"}),
+						},
+						Edges: [][2]int{
+							{0, 1},
+						},
+						Resources: flux.ResourceManagement{
+							ConcurrencyQuota: 1,
+							Priority:         flux.Low,
+						},
+					})
+					return &lang.Program{
+						Logger:   zaptest.NewLogger(t),
+						PlanSpec: ps,
+					}, nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := q.(*control.Query).Priority(); got != tc.want {
+				t.Errorf("unexpected priority: got %d want %d", got, tc.want)
+			}
+			consumeResults(t, q)
+		})
+	}
+}
+
+func TestController_TenantQuotas(t *testing.T) {
+	// executionLog records the order in which queries are executed.
+	type executionLog struct {
+		mu    sync.Mutex
+		names []string
+	}
+	// compiler returns a compiler for a query that records its name
+	// once it starts executing and then waits for the channel to be closed.
+	compiler := func(log *executionLog, name string, wait <-chan struct{}) flux.Compiler {
+		return &mock.Compiler{
+			CompileFn: func(ctx context.Context) (flux.Program, error) {
+				return &mock.Program{
+					ExecuteFn: func(ctx context.Context, q *mock.Query, alloc *memory.Allocator) {
+						log.mu.Lock()
+						log.names = append(log.names, name)
+						log.mu.Unlock()
+						if wait != nil {
+							<-wait
+						}
+					},
+				}, nil
+			},
+		}
+	}
+	// waitForExecution waits until a query has started executing.
+	waitForExecution := func(log *executionLog) {
+		for {
+			log.mu.Lock()
+			n := len(log.names)
+			log.mu.Unlock()
+			if n > 0 {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	tenantContext := func(tenant string) context.Context {
+		return context.WithValue(context.Background(), "tenant", tenant)
+	}
+
+	t.Run("weighted fair share", func(t *testing.T) {
+		config := config
+		config.QueueSize = 10
+		config.TenantLabelKey = "tenant"
+		config.TenantQuotas = map[string]control.TenantQuota{
+			"a": {Weight: 2},
+		}
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+
+		// Block the only worker until every query has been queued.
+		log := new(executionLog)
+		unblock := make(chan struct{})
+		blocker, err := ctrl.Query(context.Background(), compiler(log, "blocker", unblock))
+		if err != nil {
+			t.Fatal(err)
+		}
+		waitForExecution(log)
+
+		var queries []flux.Query
+		for _, tenant := range []string{"a", "a", "a", "a", "a", "a", "b", "b", "b"} {
+			q, err := ctrl.Query(tenantContext(tenant), compiler(log, tenant, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := q.(*control.Query).Tenant(); got != tenant {
+				t.Errorf("unexpected tenant: got %q want %q", got, tenant)
+			}
+			queries = append(queries, q)
+		}
+		close(unblock)
+		consumeResults(t, blocker)
+		for _, q := range queries {
+			consumeResults(t, q)
+		}
+
+		// Tenant a executes two queries for every query of tenant b.
+		want := []string{"blocker", "a", "b", "a", "a", "b", "a", "a", "b", "a"}
+		if !cmp.Equal(want, log.names) {
+			t.Errorf("unexpected execution order -want/+got\n%s", cmp.Diff(want, log.names))
+		}
+	})
+
+	t.Run("concurrency quota", func(t *testing.T) {
+		config := config
+		config.ConcurrencyQuota = 2
+		config.QueueSize = 10
+		config.TenantLabelKey = "tenant"
+		config.TenantQuotas = map[string]control.TenantQuota{
+			"a": {ConcurrencyQuota: 1},
+		}
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+
+		log := new(executionLog)
+		unblock := make(chan struct{})
+		var queries []flux.Query
+		for _, tenant := range []string{"a", "a", "b"} {
+			q, err := ctrl.Query(tenantContext(tenant), compiler(log, tenant, unblock))
+			if err != nil {
+				t.Fatal(err)
+			}
+			queries = append(queries, q)
+		}
+
+		// The second query of tenant a must wait for the first one,
+		// but it does not keep the query of tenant b from executing.
+		for {
+			log.mu.Lock()
+			names := append([]string(nil), log.names...)
+			log.mu.Unlock()
+			if len(names) == 2 {
+				// The queries of both tenants execute concurrently, so they start in any order.
+				sort.Strings(names)
+				if want := []string{"a", "b"}; !cmp.Equal(want, names) {
+					t.Errorf("unexpected executing queries -want/+got\n%s", cmp.Diff(want, names))
+				}
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		close(unblock)
+		for _, q := range queries {
+			consumeResults(t, q)
+		}
+		if got := log.names[len(log.names)-1]; got != "a" {
+			t.Errorf("expected the second query of tenant a to execute last, got %s", got)
+		}
+	})
+
+	t.Run("memory quota", func(t *testing.T) {
+		config := config
+		config.ConcurrencyQuota = 2
+		config.QueueSize = 10
+		config.TenantLabelKey = "tenant"
+		config.DefaultTenantQuota = control.TenantQuota{
+			MemoryBytesQuota: config.MemoryBytesQuotaPerQuery,
+		}
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+
+		log := new(executionLog)
+		unblock := make(chan struct{})
+		var queries []flux.Query
+		for _, tenant := range []string{"a", "a"} {
+			q, err := ctrl.Query(tenantContext(tenant), compiler(log, tenant, unblock))
+			if err != nil {
+				t.Fatal(err)
+			}
+			queries = append(queries, q)
+		}
+
+		// Only one query fits into the memory of the tenant even though there is a free worker.
+		waitForExecution(log)
+		time.Sleep(10 * time.Millisecond)
+		log.mu.Lock()
+		if n := len(log.names); n != 1 {
+			t.Errorf("unexpected number of executing queries: %d", n)
+		}
+		log.mu.Unlock()
+		close(unblock)
+		for _, q := range queries {
+			consumeResults(t, q)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		config := config
+		config.QueueSize = 2
+		config.TenantLabelKey = "tenant"
+		config.DefaultTenantQuota = control.TenantQuota{QueueSize: 1}
+		ctrl, err := control.New(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer shutdown(t, ctrl)
+		reg := setupPromRegistry(ctrl)
+
+		log := new(executionLog)
+		unblock := make(chan struct{})
+		blocker, err := ctrl.Query(tenantContext("a"), compiler(log, "a", unblock))
+		if err != nil {
+			t.Fatal(err)
+		}
+		waitForExecution(log)
+
+		var queries []flux.Query
+		for _, tc := range []struct {
+			tenant  string
+			wantErr bool
+		}{
+			{tenant: "a"},
+			// Tenant a has used its share of the queue.
+			{tenant: "a", wantErr: true},
+			{tenant: "b"},
+			// The queue of the controller is full.
+			{tenant: "c", wantErr: true},
+		} {
+			q, err := ctrl.Query(tenantContext(tc.tenant), compiler(log, tc.tenant, nil))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected query of tenant %s to be rejected", tc.tenant)
+				}
+				continue
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			queries = append(queries, q)
+		}
+		close(unblock)
+		consumeResults(t, blocker)
+		for _, q := range queries {
+			consumeResults(t, q)
+		}
+
+		metrics, err := reg.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, labels := range []map[string]string{
+			{"tenant": "a", "reason": "tenant_queue_full"},
+			{"tenant": "c", "reason": "queue_full"},
+		} {
+			m := FindMetric(metrics, "query_control_tenant_rejected_total", labels)
+			if m == nil {
+				t.Fatalf("missing rejected metric with labels %v", labels)
+			}
+			if got := m.Counter.GetValue(); got != 1 {
+				t.Errorf("unexpected rejected count with labels %v: %v", labels, got)
+			}
+		}
+	})
+}
+
+func TestController_InvalidTenantQuota(t *testing.T) {
+	config := config
+	config.TenantQuotas = map[string]control.TenantQuota{
+		"a": {MemoryBytesQuota: config.MemoryBytesQuotaPerQuery - 1},
+	}
+	if _, err := control.New(config); err == nil {
+		t.Fatal("expected an error for a memory quota that cannot hold a single query")
+	}
+}
+
+// consumeResults reads all of the results of the query and marks it as done.
+func consumeResults(t *testing.T, q flux.Query) {
+	t.Helper()
+	for range q.Results() {
+		// discard the results
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func shutdown(t *testing.T, ctrl *control.Controller) {
 	t.Helper()
 
@@ -0,0 +1,117 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/influxdata/flux/memory"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// memoryPool is the memory shared by the executing queries of the controller.
+//
+// A query reserves an initial amount of memory before it executes and borrows
+// more from the pool as it needs it, up to the maximum of a single query.
+// The memory of a query is returned to the pool once the query is done.
+type memoryPool struct {
+	mu     sync.Mutex
+	unused int64
+
+	// initial is the memory reserved by a query before it executes.
+	initial int64
+	// max is the most memory a single query can borrow.
+	max int64
+
+	gauge prometheus.Gauge
+	// freed is called after memory has been returned to the pool.
+	freed func()
+}
+
+func newMemoryPool(size, initial, max int64, gauge prometheus.Gauge) *memoryPool {
+	gauge.Set(float64(size))
+	return &memoryPool{
+		unused:  size,
+		initial: initial,
+		max:     max,
+		gauge:   gauge,
+	}
+}
+
+// reserve takes the initial memory of a query from the pool.
+// It reports false if the pool does not have enough unused memory.
+func (p *memoryPool) reserve() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.unused < p.initial {
+		return false
+	}
+	p.unused -= p.initial
+	p.gauge.Set(float64(p.unused))
+	return true
+}
+
+// allocator creates the allocator of a query that has reserved its initial memory.
+func (p *memoryPool) allocator() *memory.Allocator {
+	a := &memory.Allocator{
+		Limit: func(v int64) *int64 { return &v }(p.initial),
+	}
+	a.Manager = &queryMemoryManager{pool: p, alloc: a}
+	return a
+}
+
+// release returns all of the memory borrowed by the allocator of a query to the pool.
+// The limit of the allocator is set to zero, so the memory is only returned once.
+func (p *memoryPool) release(a *memory.Allocator) {
+	p.mu.Lock()
+	p.unused += atomic.SwapInt64(a.Limit, 0)
+	p.gauge.Set(float64(p.unused))
+	p.mu.Unlock()
+
+	if p.freed != nil {
+		p.freed()
+	}
+}
+
+// queryMemoryManager lends memory from the pool to the allocator of a single query.
+type queryMemoryManager struct {
+	pool  *memoryPool
+	alloc *memory.Allocator
+}
+
+// RequestMemory raises the limit of the allocator while the pool is locked,
+// so that memory borrowed by a query is never missed by a concurrent release
+// and concurrent requests cannot take the limit beyond the maximum.
+func (m *queryMemoryManager) RequestMemory(want int64) (int64, error) {
+	p := m.pool
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	limit := atomic.LoadInt64(m.alloc.Limit)
+	if limit == 0 {
+		return 0, errors.New("query memory has been returned to the pool")
+	}
+	if limit+want > p.max {
+		return 0, fmt.Errorf("query memory quota of %d bytes reached", p.max)
+	}
+	if want > p.unused {
+		return 0, fmt.Errorf("memory pool exhausted, %d bytes unused", p.unused)
+	}
+	// Borrow at least as much as the initial memory to avoid
+	// asking the pool for every allocation.
+	got := want
+	if got < p.initial {
+		got = p.initial
+	}
+	if got > p.unused {
+		got = p.unused
+	}
+	if limit+got > p.max {
+		got = p.max - limit
+	}
+	p.unused -= got
+	atomic.AddInt64(m.alloc.Limit, got)
+	p.gauge.Set(float64(p.unused))
+	return got, nil
+}
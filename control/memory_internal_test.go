@@ -0,0 +1,98 @@
+package control
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestMemoryPool_RequestMemoryWithRelease(t *testing.T) {
+	const (
+		size    = 1 << 20
+		initial = 1 << 10
+		max     = 1 << 14
+	)
+	p := newMemoryPool(size, initial, max, prometheus.NewGauge(prometheus.GaugeOpts{Name: "memory_unused"}))
+	for i := 0; i < 1000; i++ {
+		if !p.reserve() {
+			t.Fatal("unable to reserve the initial memory of a query")
+		}
+		a := p.allocator()
+
+		// Allocate from several goroutines while the memory is returned to the pool.
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for j := 0; j < 8; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				for k := 0; k < 16; k++ {
+					if err := a.Allocate(initial); err != nil {
+						return
+					}
+					if limit := atomic.LoadInt64(a.Limit); limit > max {
+						t.Errorf("query limit exceeds the maximum: %d", limit)
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			runtime.Gosched()
+			p.release(a)
+		}()
+		close(start)
+		wg.Wait()
+
+		if limit := atomic.LoadInt64(a.Limit); limit != 0 {
+			t.Fatalf("query limit was raised after its memory was returned to the pool: %d", limit)
+		}
+		p.mu.Lock()
+		unused := p.unused
+		p.mu.Unlock()
+		if unused != size {
+			t.Fatalf("memory was not returned to the pool -want/+got\n\t- %d\n\t+ %d", size, unused)
+		}
+	}
+}
+
+func TestMemoryPool_RequestMemoryRaisesLimit(t *testing.T) {
+	const (
+		size    = 1 << 20
+		initial = 1 << 10
+		max     = 1 << 12
+	)
+	p := newMemoryPool(size, initial, max, prometheus.NewGauge(prometheus.GaugeOpts{Name: "memory_unused"}))
+	if !p.reserve() {
+		t.Fatal("unable to reserve the initial memory of a query")
+	}
+	a := p.allocator()
+
+	// Requests that are not followed by an allocation still count towards the maximum.
+	var granted int64
+	for i := 0; i < 8; i++ {
+		got, err := a.Manager.RequestMemory(initial)
+		if err != nil {
+			break
+		}
+		granted += got
+	}
+	if want, got := int64(max), atomic.LoadInt64(a.Limit); want != got {
+		t.Errorf("unexpected query limit -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := int64(max-initial), granted; want != got {
+		t.Errorf("unexpected memory granted -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// The memory granted is returned with the rest of the memory of the query.
+	p.release(a)
+	if p.unused != size {
+		t.Errorf("memory was not returned to the pool -want/+got\n\t- %d\n\t+ %d", size, p.unused)
+	}
+}
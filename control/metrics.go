@@ -16,6 +16,16 @@ type controllerMetrics struct {
 	compilingDur *prometheus.HistogramVec
 	queueingDur  *prometheus.HistogramVec
 	executingDur *prometheus.HistogramVec
+
+	queueingPosition *prometheus.HistogramVec
+
+	tenantQueueing  *prometheus.GaugeVec
+	tenantExecuting *prometheus.GaugeVec
+	tenantRejected  *prometheus.CounterVec
+
+	memoryUnused prometheus.Gauge
+
+	state *prometheus.GaugeVec
 }
 
 type requestsLabel string
@@ -25,6 +35,7 @@ const (
 	labelCompileError = requestsLabel("compile_error")
 	labelRuntimeError = requestsLabel("runtime_error")
 	labelQueueError   = requestsLabel("queue_error")
+	labelTimeout      = requestsLabel("timeout")
 )
 
 func newControllerMetrics(labels []string) *controllerMetrics {
@@ -107,6 +118,49 @@ func newControllerMetrics(labels []string) *controllerMetrics {
 			Help:      "Histogram of times spent executing queries",
 			Buckets:   prometheus.ExponentialBuckets(1e-3, 5, 7),
 		}, labels),
+
+		queueingPosition: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "queueing_position",
+			Help:      "Histogram of the positions at which queries entered the priority queue of their tenant",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, labels),
+
+		tenantQueueing: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "tenant_queueing_active",
+			Help:      "Number of queries actively queueing by tenant",
+		}, []string{"tenant"}),
+
+		tenantExecuting: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "tenant_executing_active",
+			Help:      "Number of queries actively executing by tenant",
+		}, []string{"tenant"}),
+
+		tenantRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "tenant_rejected_total",
+			Help:      "Count of the queries rejected by the queue by tenant",
+		}, []string{"tenant", "reason"}),
+
+		memoryUnused: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "memory_unused_bytes",
+			Help:      "The number of bytes of the memory pool that are not borrowed by a query",
+		}),
+
+		state: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "state",
+			Help:      "The state of the query controller, the gauge of the current state is one",
+		}, []string{"state"}),
 	}
 }
 
@@ -125,5 +179,15 @@ func (cm *controllerMetrics) PrometheusCollectors() []prometheus.Collector {
 		cm.compilingDur,
 		cm.queueingDur,
 		cm.executingDur,
+
+		cm.queueingPosition,
+
+		cm.tenantQueueing,
+		cm.tenantExecuting,
+		cm.tenantRejected,
+
+		cm.memoryUnused,
+
+		cm.state,
 	}
 }
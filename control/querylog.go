@@ -0,0 +1,135 @@
+package control
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/influxdata/flux"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// QueryLogEntry describes a query that has been finished by the controller.
+type QueryLogEntry struct {
+	ID QueryID
+	// SourceHash is the hex encoded SHA-256 hash of the Flux source of the query.
+	// It is empty if the compiler of the query does not have any source.
+	SourceHash   string
+	CompilerType flux.CompilerType
+	Tenant       string
+	// Labels are the values of the MetricLabelKeys of the controller for the query.
+	Labels map[string]string
+	// Statistics are the durations and the maximum memory allocated by the query.
+	Statistics flux.Statistics
+	// ResultRows is the number of rows read by the client from each result by name.
+	ResultRows map[string]int64
+	Err        error
+	// Slow reports whether the total duration of the query exceeded
+	// the SlowQueryThreshold of the controller.
+	Slow bool
+}
+
+// QueryLogger receives an entry for every query that is finished by the controller.
+// LogQuery is called concurrently and must not block.
+type QueryLogger interface {
+	LogQuery(entry QueryLogEntry)
+}
+
+// zapQueryLogger writes the entries to a zap logger.
+// Slow queries are logged at the info level and all other queries at the debug level.
+type zapQueryLogger struct {
+	logger *zap.Logger
+}
+
+func (l zapQueryLogger) LogQuery(entry QueryLogEntry) {
+	level, msg := zapcore.DebugLevel, "Query finished"
+	if entry.Slow {
+		level, msg = zapcore.InfoLevel, "Slow query finished"
+	}
+	ce := l.logger.Check(level, msg)
+	if ce == nil {
+		return
+	}
+	stats := entry.Statistics
+	ce.Write(
+		zap.Uint64("query_id", uint64(entry.ID)),
+		zap.String("source_hash", entry.SourceHash),
+		zap.String("compiler_type", string(entry.CompilerType)),
+		zap.String("tenant", entry.Tenant),
+		zap.Any("labels", entry.Labels),
+		zap.Duration("total_duration", stats.TotalDuration),
+		zap.Duration("compile_duration", stats.CompileDuration),
+		zap.Duration("queue_duration", stats.QueueDuration),
+		zap.Duration("plan_duration", stats.PlanDuration),
+		zap.Duration("execute_duration", stats.ExecuteDuration),
+		zap.Int64("max_allocated", stats.MaxAllocated),
+		zap.Any("result_rows", entry.ResultRows),
+		zap.Error(entry.Err),
+	)
+}
+
+// logQuery sends the entry of a finished query to the query logger.
+func (c *Controller) logQuery(q *Query) {
+	stats := q.Statistics()
+	c.queryLogger.LogQuery(QueryLogEntry{
+		ID:           q.id,
+		SourceHash:   sourceHash(q.source),
+		CompilerType: q.compilerType,
+		Tenant:       q.tenant,
+		Labels:       q.Labels(),
+		Statistics:   stats,
+		ResultRows:   q.rows.counts(),
+		Err:          q.Err(),
+		Slow:         c.slowQueryThreshold > 0 && stats.TotalDuration > c.slowQueryThreshold,
+	})
+}
+
+// sourceHash returns the hex encoded SHA-256 hash of the source,
+// or an empty string if there is no source.
+func sourceHash(source string) string {
+	if source == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
+
+// resultRows counts the rows read by the client from each result of a query.
+type resultRows struct {
+	mu   sync.Mutex
+	rows map[string]int64
+}
+
+func (r *resultRows) add(name string, n int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.rows == nil {
+		r.rows = make(map[string]int64)
+	}
+	r.rows[name] += n
+}
+
+func (r *resultRows) counts() map[string]int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := make(map[string]int64, len(r.rows))
+	for name, n := range r.rows {
+		counts[name] = n
+	}
+	return counts
+}
+
+// rowCountingTable counts the rows of the table as they are read.
+type rowCountingTable struct {
+	flux.Table
+	name string
+	rows *resultRows
+}
+
+func (t *rowCountingTable) Do(f func(flux.ColReader) error) error {
+	return t.Table.Do(func(cr flux.ColReader) error {
+		t.rows.add(t.name, int64(cr.Len()))
+		return f(cr)
+	})
+}
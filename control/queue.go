@@ -0,0 +1,341 @@
+package control
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/lang"
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context that queues the queries submitted with it at the given priority.
+// Queries with a lower value are executed before queries with a higher value.
+// It takes precedence over the priority in the resources of the query.
+// Queries submitted without a priority are queued with the priority in their resources,
+// or with flux.High if their program has no resources.
+func WithPriority(ctx context.Context, p flux.Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// priorityFromContext returns the priority of the context, if it has one.
+func priorityFromContext(ctx context.Context) (flux.Priority, bool) {
+	p, ok := ctx.Value(priorityKey{}).(flux.Priority)
+	return p, ok
+}
+
+// priorityFromProgram returns the priority in the resources of a compiled program, if it has them.
+func priorityFromProgram(prog flux.Program) (flux.Priority, bool) {
+	if p, ok := prog.(*lang.Program); ok && p.PlanSpec != nil {
+		return p.PlanSpec.Resources.Priority, true
+	}
+	return 0, false
+}
+
+// maxAgingPriority is the highest priority that is told apart from the others by aging.
+// Queries with a higher priority age as if they had this priority, so that every query
+// is executed ahead of newly queued queries after waiting at most this many aging intervals.
+const maxAgingPriority = 100
+
+var (
+	errQueueFull       = errors.New("queue length exceeded")
+	errTenantQueueFull = errors.New("tenant queue length exceeded")
+)
+
+// queryQueue is a bounded queue of the queries waiting to be executed.
+//
+// Each tenant has its own priority queue. Queries are ordered by their rank, which is
+// the time the query was queued plus its priority multiplied by the aging interval.
+// A query that has been waiting for the aging interval is therefore executed before a
+// newly queued query with a priority that is one higher than its own, so low priority
+// queries are not starved. Priorities above maxAgingPriority are ranked as maxAgingPriority,
+// so even a flux.Low query is only overtaken by newer queries for a bounded time.
+// When aging is disabled, queries are ordered by priority alone.
+// Queries with the same rank are executed in the order they were queued.
+//
+// Tenants are served with start-time fair queuing. Every tenant has a virtual time that
+// advances by the inverse of its weight each time one of its queries is executed, and
+// the next query is taken from the tenant with the lowest virtual time that has not
+// reached its concurrency or memory quota.
+type queryQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	closed bool
+
+	size  int
+	len   int
+	seq   uint64
+	epoch time.Time
+	aging time.Duration
+
+	// memoryBytesPerQuery is the memory reserved by each executing query.
+	memoryBytesPerQuery int64
+
+	tenants      map[string]*tenantQueue
+	quotas       map[string]TenantQuota
+	defaultQuota TenantQuota
+	virtualTime  float64
+
+	// pool is the memory pool that a query reserves its initial memory from
+	// before it is removed from the queue. It is nil if the pool is disabled.
+	pool *memoryPool
+}
+
+// queueConfig configures a queryQueue.
+type queueConfig struct {
+	size                int
+	aging               time.Duration
+	memoryBytesPerQuery int64
+	quotas              map[string]TenantQuota
+	defaultQuota        TenantQuota
+	pool                *memoryPool
+}
+
+func newQueryQueue(c queueConfig) *queryQueue {
+	qq := &queryQueue{
+		size:                c.size,
+		epoch:               time.Now(),
+		aging:               c.aging,
+		memoryBytesPerQuery: c.memoryBytesPerQuery,
+		tenants:             make(map[string]*tenantQueue),
+		quotas:              c.quotas,
+		defaultQuota:        c.defaultQuota,
+		pool:                c.pool,
+	}
+	qq.cond = sync.NewCond(&qq.mu)
+	if qq.pool != nil {
+		// Queries may be waiting for memory to be returned to the pool.
+		qq.pool.freed = qq.wake
+	}
+	return qq
+}
+
+// tenantQueue holds the queued queries of a tenant along with its share of the resources in use.
+type tenantQueue struct {
+	name      string
+	quota     TenantQuota
+	items     queueItems
+	executing int
+	reserved  int64
+
+	// virtualTime is the virtual time at which the next query of the tenant starts.
+	virtualTime float64
+}
+
+// eligible reports whether the tenant may execute another query within its quota.
+func (t *tenantQueue) eligible(memoryBytesPerQuery int64) bool {
+	if t.quota.ConcurrencyQuota > 0 && t.executing >= t.quota.ConcurrencyQuota {
+		return false
+	}
+	if t.quota.MemoryBytesQuota > 0 && t.reserved+memoryBytesPerQuery > t.quota.MemoryBytesQuota {
+		return false
+	}
+	return true
+}
+
+// push adds the query to the queue of its tenant and returns its position in that queue,
+// where zero is the next query of the tenant to execute.
+func (qq *queryQueue) push(q *Query) (int, error) {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+
+	if qq.len >= qq.size {
+		return 0, errQueueFull
+	}
+	t := qq.tenant(q.tenant)
+	if t.quota.QueueSize > 0 && len(t.items) >= t.quota.QueueSize {
+		qq.removeIdle(t)
+		return 0, errTenantQueueFull
+	}
+	if len(t.items) == 0 && t.virtualTime < qq.virtualTime {
+		// A tenant does not accumulate credit while it has nothing queued.
+		t.virtualTime = qq.virtualTime
+	}
+	item := &queueItem{
+		q:    q,
+		rank: qq.rank(q.priority),
+		seq:  qq.seq,
+	}
+	qq.seq++
+	qq.len++
+	heap.Push(&t.items, item)
+	qq.cond.Signal()
+	return t.items.position(item), nil
+}
+
+// pop waits for a query that can be executed within the quota of its tenant
+// and the memory pool, and removes it from the queue. The resources of the query are accounted to its
+// tenant until release is called. It returns nil once the queue has been closed.
+func (qq *queryQueue) pop() *Query {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+
+	for {
+		if qq.closed {
+			return nil
+		}
+		var next *tenantQueue
+		for _, t := range qq.tenants {
+			if len(t.items) == 0 || !t.eligible(qq.memoryBytesPerQuery) {
+				continue
+			}
+			if next == nil || t.virtualTime < next.virtualTime ||
+				(t.virtualTime == next.virtualTime && t.items[0].seq < next.items[0].seq) {
+				next = t
+			}
+		}
+		if next == nil || (qq.pool != nil && !qq.pool.reserve()) {
+			qq.cond.Wait()
+			continue
+		}
+
+		qq.virtualTime = next.virtualTime
+		next.virtualTime += 1 / float64(next.quota.Weight)
+		next.executing++
+		next.reserved += qq.memoryBytesPerQuery
+		qq.len--
+		return heap.Pop(&next.items).(*queueItem).q
+	}
+}
+
+// release returns the resources of a query that was removed from the queue by pop to its tenant.
+func (qq *queryQueue) release(q *Query) {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+
+	t := qq.tenants[q.tenant]
+	t.executing--
+	t.reserved -= qq.memoryBytesPerQuery
+	qq.removeIdle(t)
+	// Queries of other tenants may be waiting for a worker
+	// while this tenant was at its quota, so wake every worker.
+	qq.cond.Broadcast()
+}
+
+// remove removes the query from the queue of its tenant.
+// It reports false if the query is not queued.
+func (qq *queryQueue) remove(q *Query) bool {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+
+	t, ok := qq.tenants[q.tenant]
+	if !ok {
+		return false
+	}
+	for i, item := range t.items {
+		if item.q == q {
+			heap.Remove(&t.items, i)
+			qq.len--
+			qq.removeIdle(t)
+			return true
+		}
+	}
+	return false
+}
+
+// wake wakes any goroutine waiting in pop to look for a query to execute again.
+func (qq *queryQueue) wake() {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	qq.cond.Broadcast()
+}
+
+// close wakes any goroutine waiting in pop and makes pop return nil.
+func (qq *queryQueue) close() {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	qq.closed = true
+	qq.cond.Broadcast()
+}
+
+// tenant returns the queue for the tenant, creating it if it does not exist.
+func (qq *queryQueue) tenant(name string) *tenantQueue {
+	t, ok := qq.tenants[name]
+	if !ok {
+		quota, ok := qq.quotas[name]
+		if !ok {
+			quota = qq.defaultQuota
+		}
+		if quota.Weight <= 0 {
+			quota.Weight = 1
+		}
+		t = &tenantQueue{
+			name:        name,
+			quota:       quota,
+			virtualTime: qq.virtualTime,
+		}
+		qq.tenants[name] = t
+	}
+	return t
+}
+
+// removeIdle forgets the tenant if it has no queued or executing queries.
+func (qq *queryQueue) removeIdle(t *tenantQueue) {
+	if len(t.items) == 0 && t.executing == 0 {
+		delete(qq.tenants, t.name)
+	}
+}
+
+// rank computes the rank of a query with the priority that is queued now.
+func (qq *queryQueue) rank(p flux.Priority) int64 {
+	if qq.aging <= 0 {
+		return int64(p)
+	}
+	if p > maxAgingPriority {
+		p = maxAgingPriority
+	}
+	waited := int64(time.Since(qq.epoch))
+	if int64(p) > (math.MaxInt64-waited)/int64(qq.aging) {
+		return math.MaxInt64
+	}
+	return waited + int64(p)*int64(qq.aging)
+}
+
+type queueItem struct {
+	q    *Query
+	rank int64
+	seq  uint64
+}
+
+func (i *queueItem) less(o *queueItem) bool {
+	if i.rank == o.rank {
+		return i.seq < o.seq
+	}
+	return i.rank < o.rank
+}
+
+// queueItems implements heap.Interface.
+type queueItems []*queueItem
+
+func (qi queueItems) Len() int           { return len(qi) }
+func (qi queueItems) Less(i, j int) bool { return qi[i].less(qi[j]) }
+func (qi queueItems) Swap(i, j int)      { qi[i], qi[j] = qi[j], qi[i] }
+
+func (qi *queueItems) Push(x interface{}) {
+	*qi = append(*qi, x.(*queueItem))
+}
+
+func (qi *queueItems) Pop() interface{} {
+	old := *qi
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*qi = old[:n-1]
+	return item
+}
+
+// position returns the number of items that will be popped before the item.
+func (qi queueItems) position(item *queueItem) int {
+	n := 0
+	for _, o := range qi {
+		if o.less(item) {
+			n++
+		}
+	}
+	return n
+}
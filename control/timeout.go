@@ -0,0 +1,54 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TimeoutError is the error of a query that exceeded the time it is allowed to spend in a state.
+type TimeoutError struct {
+	// State is the state the query was in when it timed out.
+	// It is either Queueing or Executing.
+	State State
+	// Timeout is the time the query was allowed to spend in the state.
+	Timeout time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("query exceeded the %s timeout of %s", e.State, e.Timeout)
+}
+
+type timeoutsKey struct{}
+
+// timeouts bounds the time a query may spend queueing and executing.
+// A zero duration does not bound the state.
+type timeouts struct {
+	queue   time.Duration
+	execute time.Duration
+}
+
+// WithTimeouts returns a context that bounds the time the queries submitted with it may
+// spend queueing and executing. A zero duration uses the default timeout of the controller.
+func WithTimeouts(ctx context.Context, queue, execute time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutsKey{}, timeouts{
+		queue:   queue,
+		execute: execute,
+	})
+}
+
+// timeoutsFromContext returns the timeouts of the context,
+// using the defaults for the timeouts that are not set.
+func timeoutsFromContext(ctx context.Context, defaults timeouts) timeouts {
+	t, ok := ctx.Value(timeoutsKey{}).(timeouts)
+	if !ok {
+		return defaults
+	}
+	if t.queue == 0 {
+		t.queue = defaults.queue
+	}
+	if t.execute == 0 {
+		t.execute = defaults.execute
+	}
+	return t
+}
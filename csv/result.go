@@ -71,6 +71,18 @@ type ResultDecoderConfig struct {
 	// MaxBufferCount is the maximum number of rows that will be buffered when decoding.
 	// If 0, then a value of 1000 will be used.
 	MaxBufferCount int
+	// Columns, if set, selects the columns of each table that are decoded,
+	// given the labels of all of its columns. The other columns are left out of the table.
+	Columns func(labels []string) ([]bool, error)
+	// Limit, if positive, is the maximum number of rows decoded for each table,
+	// after skipping the first Offset rows. The other rows are read but not decoded.
+	Limit  int64
+	Offset int64
+}
+
+// skipRow reports whether the row with index i in its table is outside of the limit.
+func (c ResultDecoderConfig) skipRow(i int64) bool {
+	return c.Limit > 0 && (i < c.Offset || i >= c.Offset+c.Limit)
 }
 
 func (d *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
@@ -263,6 +275,13 @@ type tableMetadata struct {
 	Groups    []bool
 	Defaults  []values.Value
 	NumFields int
+	// Selected reports which columns are decoded.
+	// All of the columns are decoded if it is nil.
+	Selected []bool
+}
+
+func (m tableMetadata) decoded(j int) bool {
+	return m.Selected == nil || m.Selected[j]
 }
 
 // readMetadata reads the table annotations and header.
@@ -405,6 +424,15 @@ func readMetadata(r *csv.Reader, c ResultDecoderConfig, extraLine []string) (tab
 		groupValues[j] = groups[j] == "true"
 	}
 
+	var selected []bool
+	if c.Columns != nil {
+		s, err := c.Columns(labels)
+		if err != nil {
+			return tableMetadata{}, err
+		}
+		selected = s
+	}
+
 	return tableMetadata{
 		ResultID:  resultID,
 		TableID:   tableID,
@@ -412,6 +440,7 @@ func readMetadata(r *csv.Reader, c ResultDecoderConfig, extraLine []string) (tab
 		Groups:    groupValues,
 		Defaults:  defaultValues,
 		NumFields: n,
+		Selected:  selected,
 	}, nil
 }
 
@@ -432,6 +461,9 @@ type tableDecoder struct {
 
 	eof       bool
 	extraLine []string
+
+	// rows is the number of rows of the table that have been read.
+	rows int64
 }
 
 func newTable(
@@ -540,6 +572,11 @@ func (d *tableDecoder) advance(extraLine []string) (bool, error) {
 			goto DONE
 		}
 
+		d.rows++
+		if d.c.skipRow(d.rows - 1) {
+			continue
+		}
+
 		record = line[recordStartIdx:]
 		err = d.appendRecord(record)
 		if err != nil {
@@ -580,7 +617,7 @@ func (d *tableDecoder) init(line []string) error {
 	keyCols := make([]flux.ColMeta, 0, len(d.meta.Cols))
 	keyValues := make([]values.Value, 0, len(d.meta.Cols))
 	for j, c := range d.meta.Cols {
-		if d.meta.Groups[j] {
+		if d.meta.Groups[j] && d.meta.decoded(j) {
 			var value values.Value
 			if record != nil && record[j] != "" {
 				// TODO: consider treatment of nullValue here
@@ -599,7 +636,10 @@ func (d *tableDecoder) init(line []string) error {
 
 	key := execute.NewGroupKey(keyCols, keyValues)
 	d.builder = execute.NewColListTableBuilder(key, newUnlimitedAllocator())
-	for _, c := range d.meta.Cols {
+	for j, c := range d.meta.Cols {
+		if !d.meta.decoded(j) {
+			continue
+		}
 		_, err := d.builder.AddCol(c.ColMeta)
 		if err != nil {
 			return err
@@ -611,17 +651,20 @@ func (d *tableDecoder) init(line []string) error {
 
 func (d *tableDecoder) appendRecord(record []string) error {
 	d.empty = false
+	k := 0
 	for j, c := range d.meta.Cols {
+		if !d.meta.decoded(j) {
+			continue
+		}
 		if record[j] == "" {
 			v := d.meta.Defaults[j]
-			if err := d.builder.AppendValue(j, v); err != nil {
+			if err := d.builder.AppendValue(k, v); err != nil {
 				return err
 			}
-			continue
-		}
-		if err := decodeValueInto(j, c, record[j], d.builder); err != nil {
+		} else if err := decodeValueInto(k, c, record[j], d.builder); err != nil {
 			return err
 		}
+		k++
 	}
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/csv"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/values"
 	"github.com/pkg/errors"
 )
@@ -624,6 +625,68 @@ func TestResultDecoder(t *testing.T) {
 				Err: errors.New("failed to create physical plan: query must specify explicit yields when there is more than one result."),
 			},
 		},
+		{
+			name:          "selected columns and limited rows",
+			encoderConfig: csv.DefaultEncoderConfig(),
+			decoderConfig: csv.ResultDecoderConfig{
+				Columns: plan.Projection{Columns: []string{"_time", "host", "_value"}}.Select,
+				Limit:   1,
+				Offset:  1,
+			},
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#group,false,false,true,true,false,true,true,false
+#default,_result,,,,,,,
+,result,table,_start,_stop,_time,_measurement,host,_value
+,,0,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:00Z,cpu,A,42
+,,0,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:01Z,cpu,A,43
+,,0,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:02Z,cpu,A,44
+,,1,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:00Z,cpu,B,52
+,,1,2018-04-17T00:00:00Z,2018-04-17T00:05:00Z,2018-04-17T00:00:01Z,cpu,B,53
+`),
+			result: &executetest.Result{
+				Nm: "_result",
+				Tbls: []*executetest.Table{
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "host", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 0, time.UTC)), "A", 43.0},
+						},
+					},
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "host", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 0, time.UTC)), "B", 53.0},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:          "selected column does not exist",
+			encoderConfig: csv.DefaultEncoderConfig(),
+			decoderConfig: csv.ResultDecoderConfig{
+				Columns: plan.Projection{Columns: []string{"_value", "region"}}.Select,
+			},
+			encoded: toCRLF(`#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,A,42
+`),
+			result: &executetest.Result{
+				Err: errors.New(`keep error: column "region" doesn't exist`),
+			},
+		},
 	}
 	testCases = append(testCases, symmetricalTestCases...)
 	for _, tc := range testCases {
@@ -20,10 +20,18 @@ type aggregateTransformation struct {
 }
 
 type AggregateConfig struct {
-	plan.DefaultCost
 	Columns []string `json:"columns"`
 }
 
+// Cost estimates that an aggregate produces a single row for each table.
+func (c AggregateConfig) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality}, plan.Statistics{
+		Cardinality:      in.GroupCardinality,
+		GroupCardinality: in.GroupCardinality,
+	}
+}
+
 var DefaultAggregateConfig = AggregateConfig{
 	Columns: []string{DefaultValueColLabel},
 }
@@ -156,16 +156,16 @@ func (a *Allocator) GrowFloats(slice []float64, n int) []float64 {
 }
 
 // Strings makes a slice of string values.
-// Only the string headers are accounted for.
+// The strings are empty, so only the string headers are accounted for.
 func (a *Allocator) Strings(l, c int) []string {
 	a.account(c, stringSize)
 	return make([]string, l, c)
 }
 
 // AppendStrings appends strings to a slice.
-// Only the string headers are accounted for.
+// Both the string headers and the bytes of the strings are accounted for.
 func (a *Allocator) AppendStrings(slice []string, vs ...string) []string {
-	//TODO(nathanielc): Account for actual size of strings
+	a.account(stringsSize(vs), 1)
 	if cap(slice)-len(slice) > len(vs) {
 		return append(slice, vs...)
 	}
@@ -175,6 +175,23 @@ func (a *Allocator) AppendStrings(slice []string, vs ...string) []string {
 	return s
 }
 
+// SetString replaces the string at index i of the slice,
+// accounting for the difference in size between the two strings.
+func (a *Allocator) SetString(slice []string, i int, v string) {
+	if diff := len(v) - len(slice[i]); diff > 0 {
+		a.account(diff, 1)
+	} else {
+		a.Free(-diff, 1)
+	}
+	slice[i] = v
+}
+
+// FreeStrings informs the allocator that the bytes of the strings have been freed.
+// The string headers must be freed separately.
+func (a *Allocator) FreeStrings(vs ...string) {
+	a.Free(stringsSize(vs), 1)
+}
+
 func (a *Allocator) GrowStrings(slice []string, n int) []string {
 	newCap := len(slice) + n
 	if newCap < cap(slice) {
@@ -219,3 +236,12 @@ func (a *Allocator) GrowTimes(slice []Time, n int) []Time {
 	a.account(diff, timeSize)
 	return s
 }
+
+// stringsSize returns the number of bytes held by the strings.
+func stringsSize(vs []string) int {
+	n := 0
+	for _, v := range vs {
+		n += len(v)
+	}
+	return n
+}
@@ -33,6 +33,7 @@ type poolDispatcher struct {
 	closing chan struct{}
 	wg      sync.WaitGroup
 	err     error
+	errs    []error
 	errC    chan error
 
 	logger *zap.Logger
@@ -92,13 +93,23 @@ func (d *poolDispatcher) Err() <-chan error {
 func (d *poolDispatcher) setErr(err error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
-	// TODO(nathanielc): Collect all error information.
+	d.errs = append(d.errs, err)
+	// Only the first error is signaled, the rest are reported by errors.
 	if d.err == nil {
 		d.err = err
 		d.errC <- err
 	}
 }
 
+// errors returns all of the errors encountered by the dispatcher.
+func (d *poolDispatcher) errors() []error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	errs := make([]error, len(d.errs))
+	copy(errs, d.errs)
+	return errs
+}
+
 //Stop the dispatcher.
 func (d *poolDispatcher) Stop() error {
 	d.mu.Lock()
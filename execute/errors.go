@@ -0,0 +1,50 @@
+package execute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/flux/plan"
+)
+
+// NodeError is an error produced by a node in the plan of a query.
+type NodeError struct {
+	NodeID plan.NodeID
+	Err    error
+}
+
+func (e *NodeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.NodeID, e.Err)
+}
+
+// Cause returns the error produced by the node.
+func (e *NodeError) Cause() error {
+	return e.Err
+}
+
+// MultiError is a list of errors that happened during the execution of a query.
+type MultiError []error
+
+func (e MultiError) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// ErrorOrNil returns nil if there are no errors, the error itself if there is
+// only one error and the MultiError otherwise.
+func (e MultiError) ErrorOrNil() error {
+	switch len(e) {
+	case 0:
+		return nil
+	case 1:
+		return e[0]
+	default:
+		return e
+	}
+}
@@ -0,0 +1,51 @@
+package execute_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influxdata/flux/execute"
+)
+
+func TestMultiError(t *testing.T) {
+	a := &execute.NodeError{NodeID: "a", Err: errors.New("first")}
+	b := &execute.NodeError{NodeID: "b", Err: errors.New("second")}
+
+	testcases := []struct {
+		name string
+		errs execute.MultiError
+		want string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "one",
+			errs: execute.MultiError{a},
+			want: "a: first",
+		},
+		{
+			name: "many",
+			errs: execute.MultiError{a, b},
+			want: "2 errors occurred: a: first; b: second",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.errs.ErrorOrNil()
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Errorf("unexpected error message -want/+got:\n\t- %q\n\t+ %q", tc.want, got)
+			}
+		})
+	}
+}
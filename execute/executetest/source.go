@@ -36,7 +36,12 @@ func (src *FromProcedureSpec) Copy() plan.ProcedureSpec {
 }
 
 func (src *FromProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
-	return plan.Cost{}, plan.Statistics{}
+	var stats plan.Statistics
+	for _, tbl := range src.data {
+		stats.Cardinality += int64(len(tbl.Data))
+		stats.GroupCardinality++
+	}
+	return plan.Cost{}, stats
 }
 
 func (src *FromProcedureSpec) AddTransformation(t execute.Transformation) {
@@ -16,6 +16,17 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+const (
+	// NodeStatisticsMetadataKey is the metadata key of the flux.NodeStatistics
+	// of each node in the plan, which the Executor reports once the nodes have finished.
+	NodeStatisticsMetadataKey = "flux/node-statistics"
+
+	// ErrorsMetadataKey is the metadata key of the errors produced by the nodes in the plan,
+	// which the Executor reports once the nodes have finished. The errors of the nodes are
+	// reported as a *NodeError and are not the errors of a canceled query.
+	ErrorsMetadataKey = "flux/errors"
+)
+
 type Executor interface {
 	// Execute will begin execution of the plan.Spec using the memory allocator.
 	// This returns a mapping of names to the query results.
@@ -62,6 +73,11 @@ type executionState struct {
 	sources []Source
 	metaCh  chan flux.Metadata
 
+	// profiles holds the statistics of every node in the plan
+	// and sourceProfiles those of the sources in the same order as sources.
+	profiles       []*nodeProfile
+	sourceProfiles []*nodeProfile
+
 	transports []Transport
 
 	dispatcher *poolDispatcher
@@ -99,9 +115,11 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 		dispatcher: newPoolDispatcher(10, e.logger),
 	}
 	v := &createExecutionNodeVisitor{
-		ctx:   ctx,
-		es:    es,
-		nodes: make(map[plan.Node]Node),
+		ctx:        ctx,
+		es:         es,
+		nodes:      make(map[plan.Node]Node),
+		profiles:   make(map[plan.Node]*nodeProfile),
+		transports: make(map[plan.Node][]*consecutiveTransport),
 	}
 
 	if err := p.BottomUpWalk(v.Visit); err != nil {
@@ -110,8 +128,8 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 
 	// Only sources can be a MetadataNode at the moment so allocate enough
 	// space for all of them to report metadata. Not all of them will necessarily
-	// report metadata.
-	es.metaCh = make(chan flux.Metadata, len(es.sources))
+	// report metadata. The statistics of the nodes are reported last.
+	es.metaCh = make(chan flux.Metadata, len(es.sources)+1)
 
 	return v.es, nil
 }
@@ -119,9 +137,12 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 // createExecutionNodeVisitor visits each node in a physical query plan
 // and creates a node responsible for executing that physical operation.
 type createExecutionNodeVisitor struct {
-	ctx   context.Context
-	es    *executionState
-	nodes map[plan.Node]Node
+	ctx      context.Context
+	es       *executionState
+	nodes    map[plan.Node]Node
+	profiles map[plan.Node]*nodeProfile
+	// transports holds the transports of the transformation of each plan node.
+	transports map[plan.Node][]*consecutiveTransport
 }
 
 func skipYields(pn plan.Node) plan.Node {
@@ -158,11 +179,16 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 
 	if yieldSpec, ok := spec.(plan.YieldProcedureSpec); ok {
 		r := newResult(yieldSpec.YieldName())
+		r.fromSource = len(skipYields(node).Predecessors()) == 0
 		v.es.results[yieldSpec.YieldName()] = r
-		v.nodes[skipYields(node)].AddTransformation(r)
+		v.addTransformation(skipYields(node), r)
 		return nil
 	}
 
+	profile := newNodeProfile(v.ctx, node, v.es.alloc)
+	v.profiles[node] = profile
+	v.es.profiles = append(v.es.profiles, profile)
+
 	// Add explicit stream context if bounds are set on this node
 	var streamContext streamContext
 	if node.Bounds() != nil {
@@ -178,6 +204,7 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		es:            v.es,
 		parents:       make([]DatasetID, len(node.Predecessors())),
 		streamContext: streamContext,
+		alloc:         profile.alloc,
 	}
 
 	for i, pred := range nonYieldPredecessors(node) {
@@ -199,6 +226,8 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		}
 
 		v.es.sources = append(v.es.sources, source)
+		v.es.sourceProfiles = append(v.es.sourceProfiles, profile)
+		profile.pending = 1
 		v.nodes[node] = source
 	} else {
 
@@ -223,34 +252,78 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		v.nodes[node] = ds
 
 		for _, p := range nonYieldPredecessors(node) {
-			executionNode := v.nodes[p]
-			transport := newConsecutiveTransport(v.es.dispatcher, tr)
+			transport := newConsecutiveTransport(v.ctx, v.es.dispatcher, tr, profile, v.es.messageQueueSize())
+			transport.fromSource = len(p.Predecessors()) == 0
+			transport.connect(v.transports[p])
+			v.transports[node] = append(v.transports[node], transport)
+			profile.pending++
 			v.es.transports = append(v.es.transports, transport)
-			executionNode.AddTransformation(transport)
+			v.addTransformation(p, transport)
 		}
 
 		if plan.HasSideEffect(spec) && len(node.Successors()) == 0 {
 			name := string(node.ID())
 			r := newResult(name)
+			r.fromSource = len(node.Predecessors()) == 0
 			v.es.results[name] = r
-			v.nodes[skipYields(node)].AddTransformation(r)
+			v.addTransformation(skipYields(node), r)
 		}
 	}
 
 	return nil
 }
 
+// addTransformation adds the transformation to the execution node of the plan node.
+// The tables produced by the node are counted by the first transformation added to it,
+// so the tables sent to more than one transformation are only counted once.
+func (v *createExecutionNodeVisitor) addTransformation(node plan.Node, t Transformation) {
+	if p := v.profiles[node]; !p.hasOutput {
+		p.hasOutput = true
+		t = &outputCountingTransformation{Transformation: t, profile: p}
+	}
+	v.nodes[node].AddTransformation(t)
+}
+
+// messageQueueSize returns the size of the message queue of each transport.
+func (es *executionState) messageQueueSize() int {
+	if es.p.MessageQueueSize > 0 {
+		return es.p.MessageQueueSize
+	}
+	return plan.DefaultMessageQueueSize
+}
+
 func (es *executionState) abort(err error) {
 	for _, r := range es.results {
 		r.(*result).abort(err)
 	}
 }
 
+// report returns the statistics and the errors of the nodes in the plan.
+func (es *executionState) report() flux.Metadata {
+	md := make(flux.Metadata)
+	for _, p := range es.profiles {
+		md.Add(NodeStatisticsMetadataKey, p.statistics())
+		if err := p.error(); err != nil && !isCanceled(err) {
+			md.Add(ErrorsMetadataKey, &NodeError{NodeID: p.id, Err: err})
+		}
+	}
+	for _, err := range es.dispatcher.errors() {
+		md.Add(ErrorsMetadataKey, err)
+	}
+	return md
+}
+
+// isCanceled reports whether the error is caused by the query being canceled.
+func isCanceled(err error) bool {
+	err = errors.Cause(err)
+	return err == context.Canceled || err == context.DeadlineExceeded
+}
+
 func (es *executionState) do(ctx context.Context) {
 	var wg sync.WaitGroup
-	for _, src := range es.sources {
+	for i, src := range es.sources {
 		wg.Add(1)
-		go func(src Source) {
+		go func(src Source, profile *nodeProfile) {
 			defer wg.Done()
 
 			// Setup panic handling on the source goroutines
@@ -264,6 +337,7 @@ func (es *executionState) do(ctx context.Context) {
 					default:
 						err = fmt.Errorf("%v", e)
 					}
+					profile.setErr(err)
 
 					if _, ok := err.(memory.LimitExceededError); ok {
 						es.abort(err)
@@ -277,21 +351,22 @@ func (es *executionState) do(ctx context.Context) {
 					}
 				}
 			}()
+			start := time.Now()
+			profile.startSpan()
 			src.Run(ctx)
+			profile.addProcess(time.Since(start))
+			profile.done()
 
 			if mdn, ok := src.(MetadataNode); ok {
 				es.metaCh <- mdn.Metadata()
 			}
-		}(src)
+		}(src, es.sourceProfiles[i])
 	}
 
-	go func() {
-		defer close(es.metaCh)
-		wg.Wait()
-	}()
-
 	es.dispatcher.Start(es.resources.ConcurrencyQuota, ctx)
+	wg.Add(1)
 	go func() {
+		defer wg.Done()
 		// Wait for all transports to finish
 		for _, t := range es.transports {
 			select {
@@ -310,6 +385,18 @@ func (es *executionState) do(ctx context.Context) {
 			es.abort(err)
 		}
 	}()
+
+	go func() {
+		defer close(es.metaCh)
+		wg.Wait()
+		// Every node has finished, so its statistics are complete.
+		// The spans of the nodes that did not finish because the
+		// execution was aborted are finished now.
+		for _, p := range es.profiles {
+			p.finishSpan()
+		}
+		es.metaCh <- es.report()
+	}()
 }
 
 // Need a unique stream context per execution context
@@ -318,6 +405,7 @@ type executionContext struct {
 	es            *executionState
 	parents       []DatasetID
 	streamContext streamContext
+	alloc         *memory.Allocator
 }
 
 func resolveTime(qt flux.Time, now time.Time) Time {
@@ -336,8 +424,10 @@ func (ec executionContext) StreamContext() StreamContext {
 	return ec.streamContext
 }
 
+// Allocator returns the allocator of the node, which charges
+// the allocator of the query for the memory it allocates.
 func (ec executionContext) Allocator() *memory.Allocator {
-	return ec.es.alloc
+	return ec.alloc
 }
 
 func (ec executionContext) Parents() []DatasetID {
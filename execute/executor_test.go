@@ -17,6 +17,8 @@ import (
 	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -782,3 +784,302 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutor_NodeStatistics(t *testing.T) {
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(
+				[]*executetest.Table{{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), execute.Time(5), execute.Time(0), 1.0},
+						{execute.Time(0), execute.Time(5), execute.Time(1), 2.0},
+						{execute.Time(0), execute.Time(5), execute.Time(2), 3.0},
+						{execute.Time(0), execute.Time(5), execute.Time(3), 4.0},
+						{execute.Time(0), execute.Time(5), execute.Time(4), 5.0},
+					},
+				}},
+			)),
+			plan.CreatePhysicalNode("filter", &universe.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Block: &semantic.FunctionBlock{
+						Parameters: &semantic.FunctionParameters{
+							List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+						},
+						Body: &semantic.BinaryExpression{
+							Operator: ast.LessThanOperator,
+							Left: &semantic.MemberExpression{
+								Property: "_value",
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+							},
+							Right: &semantic.FloatLiteral{Value: 2.5},
+						},
+					},
+				},
+			}),
+			plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{1, 2},
+		},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	}
+
+	exe := execute.NewExecutor(nil, zaptest.NewLogger(t))
+	results, metaCh, err := exe.Execute(context.Background(), plantest.CreatePlanSpec(spec), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []flux.NodeStatistics
+	for md := range metaCh {
+		for _, n := range md[execute.NodeStatisticsMetadataKey] {
+			got = append(got, n.(flux.NodeStatistics))
+		}
+	}
+	if len(got) != 2 {
+		t.Fatalf("unexpected number of node statistics: %d", len(got))
+	}
+	for _, n := range got {
+		if n.ProcessDuration <= 0 {
+			t.Errorf("unexpected process duration of node %s: %v", n.ID, n.ProcessDuration)
+		}
+		n.ProcessDuration, n.DispatchDuration = 0, 0
+		switch n.ID {
+		case "from-test":
+			if want := (flux.NodeStatistics{ID: "from-test", Kind: executetest.FromTestKind, TablesOut: 1, RowsOut: 5}); !cmp.Equal(want, n) {
+				t.Errorf("unexpected statistics of node %s -want/+got\n%s", n.ID, cmp.Diff(want, n))
+			}
+		case "filter":
+			if n.MaxAllocated <= 0 {
+				t.Errorf("unexpected max allocated of node %s: %d", n.ID, n.MaxAllocated)
+			}
+			n.MaxAllocated = 0
+			if want := (flux.NodeStatistics{ID: "filter", Kind: universe.FilterKind, TablesIn: 1, RowsIn: 5, TablesOut: 1, RowsOut: 2}); !cmp.Equal(want, n) {
+				t.Errorf("unexpected statistics of node %s -want/+got\n%s", n.ID, cmp.Diff(want, n))
+			}
+		default:
+			t.Errorf("unexpected node %s", n.ID)
+		}
+	}
+}
+
+func TestExecutor_Tracing(t *testing.T) {
+	tracer := mocktracer.New()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+
+	testcases := []struct {
+		name      string
+		spec      *plantest.PlanSpec
+		allocator *memory.Allocator
+		want      map[string]map[string]interface{}
+		wantErr   bool
+	}{
+		{
+			name: "from with filter",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(
+						[]*executetest.Table{{
+							ColMeta: []flux.ColMeta{
+								{Label: "_value", Type: flux.TFloat},
+							},
+							Data: [][]interface{}{{1.0}, {2.0}, {3.0}},
+						}},
+					)),
+					plan.CreatePhysicalNode("filter", &universe.FilterProcedureSpec{
+						Fn: &semantic.FunctionExpression{
+							Block: &semantic.FunctionBlock{
+								Parameters: &semantic.FunctionParameters{
+									List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+								},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.LessThanOperator,
+									Left: &semantic.MemberExpression{
+										Property: "_value",
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+									},
+									Right: &semantic.FloatLiteral{Value: 2.5},
+								},
+							},
+						},
+					}),
+					plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+			want: map[string]map[string]interface{}{
+				"from-test": {
+					"kind":       executetest.FromTestKind,
+					"node_id":    "from-test",
+					"tables_in":  int64(0),
+					"rows_in":    int64(0),
+					"tables_out": int64(1),
+				},
+				"filter": {
+					"kind":       universe.FilterKind,
+					"node_id":    "filter",
+					"tables_in":  int64(1),
+					"rows_in":    int64(3),
+					"tables_out": int64(1),
+					"rows_out":   int64(2),
+				},
+			},
+		},
+		{
+			name: "source error",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("allocating-from-test", &executetest.AllocatingFromProcedureSpec{ByteCount: 65}),
+					plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+				},
+				Edges: [][2]int{
+					{0, 1},
+				},
+			},
+			allocator: &memory.Allocator{Limit: func(v int64) *int64 { return &v }(64)},
+			want: map[string]map[string]interface{}{
+				"allocating-from-test": {
+					"kind":       executetest.AllocatingFromTestKind,
+					"node_id":    "allocating-from-test",
+					"tables_in":  int64(0),
+					"rows_in":    int64(0),
+					"tables_out": int64(0),
+					"error":      true,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tracer.Reset()
+			tc.spec.Resources = flux.ResourceManagement{
+				ConcurrencyQuota: 1,
+				MemoryBytesQuota: math.MaxInt64,
+			}
+			tc.spec.Now = time.Now()
+			alloc := tc.allocator
+			if alloc == nil {
+				alloc = &memory.Allocator{}
+			}
+
+			parent := tracer.StartSpan("execute")
+			ctx := opentracing.ContextWithSpan(context.Background(), parent)
+			exe := execute.NewExecutor(nil, zaptest.NewLogger(t))
+			results, metaCh, err := exe.Execute(ctx, plantest.CreatePlanSpec(tc.spec), alloc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range results {
+				err = r.Tables().Do(func(tbl flux.Table) error {
+					_, err := executetest.ConvertTable(tbl)
+					return err
+				})
+			}
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for range metaCh {
+				// wait for the nodes to finish
+			}
+			parent.Finish()
+
+			got := make(map[string]map[string]interface{})
+			for _, span := range tracer.FinishedSpans() {
+				if span == parent {
+					continue
+				}
+				if span.ParentID != parent.(*mocktracer.MockSpan).SpanContext.SpanID {
+					t.Errorf("span %s is not a child of the execute span", span.OperationName)
+				}
+				tags := span.Tags()
+				if span.OperationName != "filter" {
+					// The rows of the tables produced by the sources are counted as they are read,
+					// which may happen after the source has finished.
+					delete(tags, "rows_out")
+				}
+				got[span.OperationName] = tags
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected spans -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestExecutor_Errors(t *testing.T) {
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-a", &executetest.AllocatingFromProcedureSpec{ByteCount: 65}),
+			plan.CreatePhysicalNode("yield-a", executetest.NewYieldProcedureSpec("a")),
+			plan.CreatePhysicalNode("from-b", &executetest.AllocatingFromProcedureSpec{ByteCount: 65}),
+			plan.CreatePhysicalNode("yield-b", executetest.NewYieldProcedureSpec("b")),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{2, 3},
+		},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	}
+
+	exe := execute.NewExecutor(nil, zaptest.NewLogger(t))
+	alloc := &memory.Allocator{Limit: func(v int64) *int64 { return &v }(64)}
+	results, metaCh, err := exe.Execute(context.Background(), plantest.CreatePlanSpec(spec), alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			return nil
+		}); err == nil {
+			t.Errorf("expected an error from result %s", name)
+		}
+	}
+
+	got := make(map[plan.NodeID]bool)
+	for md := range metaCh {
+		for _, e := range md[execute.ErrorsMetadataKey] {
+			ne, ok := e.(*execute.NodeError)
+			if !ok {
+				t.Errorf("unexpected error type %T: %v", e, e)
+				continue
+			}
+			if ne.Err == nil {
+				t.Errorf("missing error of node %s", ne.NodeID)
+			}
+			got[ne.NodeID] = true
+		}
+	}
+	if want := map[plan.NodeID]bool{"from-a": true, "from-b": true}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected node errors -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
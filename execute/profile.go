@@ -0,0 +1,183 @@
+package execute
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// nodeProfile collects the runtime statistics of a single plan node
+// and traces its execution with a span that is a child of the span of the query.
+// The counters are updated concurrently, so they must only be accessed with atomic operations.
+type nodeProfile struct {
+	id     plan.NodeID
+	kind   plan.ProcedureKind
+	source bool
+	alloc  *memory.Allocator
+
+	tablesIn, rowsIn   int64
+	tablesOut, rowsOut int64
+	process, dispatch  int64
+
+	// hasOutput is set once the output of the node is being counted.
+	// It is only used while the execution state is created.
+	hasOutput bool
+
+	ctx        context.Context
+	span       opentracing.Span
+	startOnce  sync.Once
+	finishOnce sync.Once
+	// pending is the number of transports or sources of the node
+	// that have not finished yet.
+	pending int32
+	errMu   sync.Mutex
+	err     error
+}
+
+func newNodeProfile(ctx context.Context, node plan.Node, parent *memory.Allocator) *nodeProfile {
+	return &nodeProfile{
+		id:     node.ID(),
+		kind:   node.Kind(),
+		source: len(node.Predecessors()) == 0,
+		alloc:  &memory.Allocator{Parent: parent},
+		ctx:    ctx,
+	}
+}
+
+// startSpan starts the span of the node the first time the node does any work.
+func (p *nodeProfile) startSpan() {
+	p.startOnce.Do(func() {
+		p.span, _ = opentracing.StartSpanFromContext(p.ctx, string(p.kind))
+		p.span.SetTag("kind", string(p.kind))
+		p.span.SetTag("node_id", string(p.id))
+	})
+}
+
+// done marks one of the transports or the source of the node as finished.
+// The span of the node is finished once all of them are.
+func (p *nodeProfile) done() {
+	if atomic.AddInt32(&p.pending, -1) == 0 {
+		p.finishSpan()
+	}
+}
+
+// finishSpan finishes the span of the node with its statistics and error.
+// The rows out only include the rows that have been read by the time the node finished.
+// The span is not reported if the node never started.
+func (p *nodeProfile) finishSpan() {
+	p.finishOnce.Do(func() {
+		if p.span == nil {
+			return
+		}
+		p.span.SetTag("tables_in", atomic.LoadInt64(&p.tablesIn))
+		p.span.SetTag("rows_in", atomic.LoadInt64(&p.rowsIn))
+		p.span.SetTag("tables_out", atomic.LoadInt64(&p.tablesOut))
+		p.span.SetTag("rows_out", atomic.LoadInt64(&p.rowsOut))
+		if err := p.error(); err != nil {
+			ext.Error.Set(p.span, true)
+			p.span.LogFields(log.Error(err))
+		}
+		p.span.Finish()
+	})
+}
+
+// setErr records the error of the node. Only the first error is kept.
+func (p *nodeProfile) setErr(err error) {
+	p.errMu.Lock()
+	defer p.errMu.Unlock()
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *nodeProfile) error() error {
+	p.errMu.Lock()
+	defer p.errMu.Unlock()
+	return p.err
+}
+
+// countIn records a table received by the node.
+func (p *nodeProfile) countIn(tbl flux.Table) flux.Table {
+	atomic.AddInt64(&p.tablesIn, 1)
+	return countRows(tbl, &p.rowsIn)
+}
+
+// countOut records a table produced by the node.
+func (p *nodeProfile) countOut(tbl flux.Table) flux.Table {
+	atomic.AddInt64(&p.tablesOut, 1)
+	return countRows(tbl, &p.rowsOut)
+}
+
+func (p *nodeProfile) addProcess(d time.Duration) {
+	atomic.AddInt64(&p.process, int64(d))
+}
+
+func (p *nodeProfile) addDispatch(d time.Duration) {
+	atomic.AddInt64(&p.dispatch, int64(d))
+}
+
+func (p *nodeProfile) statistics() flux.NodeStatistics {
+	return flux.NodeStatistics{
+		ID:               string(p.id),
+		Kind:             string(p.kind),
+		TablesIn:         atomic.LoadInt64(&p.tablesIn),
+		RowsIn:           atomic.LoadInt64(&p.rowsIn),
+		TablesOut:        atomic.LoadInt64(&p.tablesOut),
+		RowsOut:          atomic.LoadInt64(&p.rowsOut),
+		ProcessDuration:  time.Duration(atomic.LoadInt64(&p.process)),
+		DispatchDuration: time.Duration(atomic.LoadInt64(&p.dispatch)),
+		MaxAllocated:     p.alloc.MaxAllocated(),
+	}
+}
+
+// countRows adds the number of rows of the table to n.
+// The rows of a ColListTable are known up front. Any other table
+// is wrapped so that its rows are counted as they are read.
+func countRows(tbl flux.Table, n *int64) flux.Table {
+	if t, ok := tbl.(*ColListTable); ok {
+		atomic.AddInt64(n, int64(t.NRows()))
+		return tbl
+	}
+	return &rowCountingTable{Table: tbl, n: n}
+}
+
+// rowCountingTable counts the rows of a table as they are read.
+type rowCountingTable struct {
+	flux.Table
+	n *int64
+}
+
+func (t *rowCountingTable) Do(f func(flux.ColReader) error) error {
+	return t.Table.Do(func(cr flux.ColReader) error {
+		atomic.AddInt64(t.n, int64(cr.Len()))
+		return f(cr)
+	})
+}
+
+// outputCountingTransformation records the tables produced by a node
+// before passing them on to the transformation.
+type outputCountingTransformation struct {
+	Transformation
+	profile *nodeProfile
+}
+
+func (t *outputCountingTransformation) Process(id DatasetID, tbl flux.Table) error {
+	return t.Transformation.Process(id, t.profile.countOut(tbl))
+}
+
+func (t *outputCountingTransformation) Finish(id DatasetID, err error) {
+	// A transformation also finishes with the errors of the nodes before it,
+	// so only the error of a source is its own.
+	if err != nil && t.profile.source {
+		t.profile.setErr(err)
+	}
+	t.Transformation.Finish(id, err)
+}
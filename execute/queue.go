@@ -12,21 +12,29 @@ type MessageQueue interface {
 	Pop() Message
 }
 
-type unboundedMessageQueue struct {
+// boundedMessageQueue is a MessageQueue that is full once it holds size messages.
+// Push never blocks, so the queue may grow beyond its size. It is up to the
+// producers to wait for the queue to have room before pushing to it.
+type boundedMessageQueue struct {
 	buf  []Message
 	head int
 	tail int
 	mu   sync.Mutex
 	len  int32
+	size int32
+
+	// room is closed once a full queue has room again.
+	room chan struct{}
 }
 
-func newMessageQueue(n int) *unboundedMessageQueue {
-	return &unboundedMessageQueue{
-		buf: make([]Message, n),
+func newMessageQueue(size int) *boundedMessageQueue {
+	return &boundedMessageQueue{
+		buf:  make([]Message, size+1),
+		size: int32(size),
 	}
 }
 
-func (q *unboundedMessageQueue) Push(m Message) {
+func (q *boundedMessageQueue) Push(m Message) {
 	q.mu.Lock()
 	size := len(q.buf)
 	q.tail = (q.tail + 1) % size
@@ -44,11 +52,36 @@ func (q *unboundedMessageQueue) Push(m Message) {
 	q.mu.Unlock()
 }
 
-func (q *unboundedMessageQueue) Len() int {
+func (q *boundedMessageQueue) Len() int {
 	return int(atomic.LoadInt32(&q.len))
 }
 
-func (q *unboundedMessageQueue) Pop() Message {
+// Full reports whether the queue holds at least as many messages as its size.
+func (q *boundedMessageQueue) Full() bool {
+	return atomic.LoadInt32(&q.len) >= q.size
+}
+
+// Room returns a channel that is closed once the queue is not full.
+func (q *boundedMessageQueue) Room() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.Full() {
+		return roomAvailable
+	}
+	if q.room == nil {
+		q.room = make(chan struct{})
+	}
+	return q.room
+}
+
+// roomAvailable is the closed channel returned by Room when the queue is not full.
+var roomAvailable = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+func (q *boundedMessageQueue) Pop() Message {
 	if q.Len() == 0 {
 		return nil
 	}
@@ -59,6 +92,10 @@ func (q *unboundedMessageQueue) Pop() Message {
 	m := q.buf[q.head]
 	q.buf[q.head] = nil
 	atomic.AddInt32(&q.len, -1)
+	if q.room != nil && !q.Full() {
+		close(q.room)
+		q.room = nil
+	}
 	q.mu.Unlock()
 	return m
 }
@@ -10,6 +10,10 @@ import (
 // mapping the pushed based Transformation API to the pull based Result interface.
 type result struct {
 	name string
+	// fromSource is set when the tables are sent by a source.
+	// Sources do not hold a reference to their tables for the result,
+	// so the result takes one itself.
+	fromSource bool
 
 	mu     sync.Mutex
 	tables chan resultMessage
@@ -42,6 +46,9 @@ func (s *result) RetractTable(DatasetID, flux.GroupKey) error {
 }
 
 func (s *result) Process(id DatasetID, tbl flux.Table) error {
+	if s.fromSource {
+		tbl.RefCount(1)
+	}
 	select {
 	case s.tables <- resultMessage{
 		table: tbl,
@@ -55,6 +62,9 @@ func (s *result) Tables() flux.TableIterator {
 	return s
 }
 
+// Do calls f with each table of the result.
+// The reference held by the result is released once f returns,
+// so a table must not be used after that unless f retains it.
 func (s *result) Do(f func(flux.Table) error) error {
 	for {
 		select {
@@ -67,7 +77,9 @@ func (s *result) Do(f func(flux.Table) error) error {
 			if msg.err != nil {
 				return msg.err
 			}
-			if err := f(msg.table); err != nil {
+			err := f(msg.table)
+			msg.table.RefCount(-1)
+			if err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,46 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+)
+
+// refCountTable is an empty table that counts the references held on it.
+type refCountTable struct {
+	refs int
+}
+
+func (t *refCountTable) Key() flux.GroupKey                    { return NewGroupKey(nil, nil) }
+func (t *refCountTable) Cols() []flux.ColMeta                  { return nil }
+func (t *refCountTable) Do(f func(flux.ColReader) error) error { return nil }
+func (t *refCountTable) Empty() bool                           { return true }
+func (t *refCountTable) RefCount(n int)                        { t.refs += n }
+
+func TestResult_ReleasesTables(t *testing.T) {
+	for _, fromSource := range []bool{false, true} {
+		r := newResult("_result")
+		r.fromSource = fromSource
+		tbl := &refCountTable{}
+		if !fromSource {
+			// A dataset holds a reference for each of its transformations.
+			tbl.RefCount(1)
+		}
+		if err := r.Process(DatasetID{}, tbl); err != nil {
+			t.Fatal(err)
+		}
+		r.Finish(DatasetID{}, nil)
+
+		if err := r.Do(func(flux.Table) error {
+			if tbl.refs != 1 {
+				t.Errorf("unexpected references while reading a table from source %v: %d", fromSource, tbl.refs)
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if tbl.refs != 0 {
+			t.Errorf("unexpected references after reading a table from source %v: %d", fromSource, tbl.refs)
+		}
+	}
+}
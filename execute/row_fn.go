@@ -76,6 +76,11 @@ func (f *rowFn) prepare(cols []flux.ColMeta, extraTypes map[string]semantic.Type
 	return nil
 }
 
+// References returns the labels of the columns whose values the function reads.
+func (f *rowFn) References() []string {
+	return f.references
+}
+
 func ConvertToKind(t flux.ColType) semantic.Nature {
 	// TODO make this an array lookup.
 	switch t {
@@ -137,6 +142,20 @@ func (f *rowFn) eval(row int, cr flux.ColReader, extraParams map[string]values.V
 	return f.preparedFn.Eval(f.inRecord)
 }
 
+// evalValues evaluates the function for a row that is given as the values
+// of the columns the function was prepared for.
+func (f *rowFn) evalValues(vs []values.Value) (values.Value, error) {
+	for _, r := range f.references {
+		v := vs[f.recordCols[r]]
+		if v.IsNull() {
+			return nil, errors.New("null reference used in row function: skipping evaluation until null support is provided")
+		}
+		f.record.Set(r, v)
+	}
+	f.inRecord.Set(f.recordName, f.record)
+	return f.preparedFn.Eval(f.inRecord)
+}
+
 func (f *rowFn) anyNilReferenceInRow(i int, cr flux.ColReader) bool {
 	for _, ref := range f.references {
 		j := ColIdx(ref, cr.Cols())
@@ -210,6 +229,15 @@ func (f *RowPredicateFn) Eval(row int, cr flux.ColReader) (bool, error) {
 	return v.Bool(), nil
 }
 
+// EvalValues evaluates the predicate for a row given as the values of the prepared columns.
+func (f *RowPredicateFn) EvalValues(vs []values.Value) (bool, error) {
+	v, err := f.rowFn.evalValues(vs)
+	if err != nil {
+		return false, err
+	}
+	return v.Bool(), nil
+}
+
 type RowMapFn struct {
 	rowFn
 
@@ -261,6 +289,20 @@ func (f *RowMapFn) Eval(row int, cr flux.ColReader) (values.Object, error) {
 	return v.Object(), nil
 }
 
+// EvalValues evaluates the function for a row given as the values of the prepared columns.
+// The returned object may be reused by the next evaluation.
+func (f *RowMapFn) EvalValues(vs []values.Value) (values.Object, error) {
+	v, err := f.rowFn.evalValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	if f.isWrap {
+		f.wrapObj.Set(DefaultValueColLabel, v)
+		return f.wrapObj, nil
+	}
+	return v.Object(), nil
+}
+
 type RowReduceFn struct {
 	rowFn
 	isWrap  bool
@@ -19,10 +19,18 @@ type selectorTransformation struct {
 }
 
 type SelectorConfig struct {
-	plan.DefaultCost
 	Column string `json:"column"`
 }
 
+// Cost estimates that a selector selects a single row from each table.
+func (c SelectorConfig) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality}, plan.Statistics{
+		Cardinality:      in.GroupCardinality,
+		GroupCardinality: in.GroupCardinality,
+	}
+}
+
 var DefaultSelectorConfig = SelectorConfig{
 	Column: DefaultValueColLabel,
 }
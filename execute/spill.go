@@ -0,0 +1,1006 @@
+package execute
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// SpillConfigKey is the key of the SpillConfig in the execution Dependencies.
+const SpillConfigKey = "spill"
+
+// spillChunkSize is the maximum number of rows that are read back from disk at once.
+const spillChunkSize = 1024
+
+// SpillConfig configures transformations that hold entire tables in memory,
+// such as sort, pivot and join, to write their data to disk
+// instead of failing when a query reaches its memory limit.
+type SpillConfig struct {
+	// Dir is the directory in which temporary files are created.
+	// When empty, the default directory for temporary files is used.
+	Dir string
+}
+
+// GetSpillConfig returns the spill configuration from the dependencies.
+// It returns nil when spilling to disk is disabled.
+func GetSpillConfig(deps Dependencies) *SpillConfig {
+	c, _ := deps[SpillConfigKey].(*SpillConfig)
+	return c
+}
+
+// Spiller writes tables to a temporary file when a query is about to
+// reach its memory limit, and reads them back in small chunks.
+//
+// The file is created when the first table is written. It is removed once
+// the Spiller is closed and every table read from it has been released.
+// Only one SpillWriter may be open at a time.
+type Spiller struct {
+	config *SpillConfig
+	alloc  *memory.Allocator
+
+	mu      sync.Mutex
+	f       *os.File
+	size    int64
+	writing bool
+
+	// mergeMu serializes the merge passes that write intermediate runs.
+	mergeMu sync.Mutex
+
+	// refs counts the owner of the spiller and each table that is in use.
+	refs int32
+}
+
+// NewSpiller creates a Spiller that writes tables to disk using config
+// and reads them back into memory accounted for by alloc.
+// A Spiller with a nil config never spills.
+func NewSpiller(config *SpillConfig, alloc *memory.Allocator) *Spiller {
+	return &Spiller{
+		config: config,
+		alloc:  alloc,
+		refs:   1,
+	}
+}
+
+// Enabled reports whether tables can be spilled to disk.
+// Spilling requires both a configuration and a memory limit.
+func (s *Spiller) Enabled() bool {
+	return s.config != nil && s.alloc != nil && s.alloc.Limited()
+}
+
+// ShouldSpill reports whether allocating n more bytes could exceed the memory limit of the query.
+// It is always false when spilling is disabled.
+func (s *Spiller) ShouldSpill(n int64) bool {
+	if !s.Enabled() {
+		return false
+	}
+	return n > s.alloc.Available()
+}
+
+// AppendSize estimates the number of bytes allocated by appending the rows of cr to the builder.
+func AppendSize(b TableBuilder, cr flux.ColReader) int64 {
+	n := b.NRows()
+	// Builders grow by half of their length when they run out of capacity.
+	rows := (n+cr.Len())*3/2 + 1 - n
+	size := rows * rowSize(cr.Cols())
+	for j, c := range cr.Cols() {
+		if c.Type == flux.TString {
+			size += len(cr.Strings(j).ValueBytes())
+		}
+	}
+	return int64(size)
+}
+
+// TableSize estimates the number of bytes used by a table with the number of rows and the columns.
+// The bytes of string values are not included.
+func TableSize(nrows int, cols []flux.ColMeta) int64 {
+	return int64(nrows * rowSize(cols))
+}
+
+// MergeSize estimates the number of bytes needed to merge n spilled tables with the columns at once.
+// A chunk of each table is held in memory, and the merged rows are buffered in another chunk.
+func MergeSize(n int, cols []flux.ColMeta) int64 {
+	return TableSize((n+2)*spillChunkSize, cols)
+}
+
+// rowSize returns the number of bytes accounted for a single row with the columns.
+func rowSize(cols []flux.ColMeta) int {
+	size := 0
+	for _, c := range cols {
+		switch c.Type {
+		case flux.TBool:
+			size += boolSize
+		case flux.TInt:
+			size += int64Size
+		case flux.TUInt:
+			size += uint64Size
+		case flux.TFloat:
+			size += float64Size
+		case flux.TString:
+			size += stringSize
+		case flux.TTime:
+			size += timeSize
+		}
+	}
+	return size
+}
+
+// Spill writes the table to disk and returns a table that reads it back.
+func (s *Spiller) Spill(tbl flux.Table) (flux.Table, error) {
+	w, err := s.NewWriter(tbl.Key(), tbl.Cols())
+	if err != nil {
+		return nil, err
+	}
+	if err := tbl.Do(w.Write); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w.Table()
+}
+
+// SpillBuilder writes the rows of the builder to disk and returns a table that reads them back.
+// The builder is not modified, and its rows are copied a few at a time
+// so that little memory is needed to spill it.
+func (s *Spiller) SpillBuilder(b TableBuilder) (flux.Table, error) {
+	clb, ok := b.(*ColListTableBuilder)
+	if !ok {
+		tbl, err := b.Table()
+		if err != nil {
+			return nil, err
+		}
+		tbl.RefCount(1)
+		defer tbl.RefCount(-1)
+		return s.Spill(tbl)
+	}
+	w, err := s.NewWriter(b.Key(), b.Cols())
+	if err != nil {
+		return nil, err
+	}
+	if err := w.WriteBuilder(clb); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w.Table()
+}
+
+// chunk copies the rows in the range [start, stop) into a table.
+// The table is not accounted for by the allocator of the builder
+// and must be released with RefCount(-1).
+func (b *ColListTableBuilder) chunk(start, stop int) *ColListTable {
+	tbl := &ColListTable{
+		key:      b.key,
+		colMeta:  b.colMeta,
+		cols:     make([]column, len(b.cols)),
+		nrows:    stop - start,
+		refCount: 1,
+	}
+	for j, c := range b.cols {
+		switch c := c.(type) {
+		case *boolColumnBuilder:
+			vb := arrow.NewBoolBuilder(nil)
+			vb.Reserve(stop - start)
+			for i := start; i < stop; i++ {
+				if c.IsNil(i) {
+					vb.AppendNull()
+				} else {
+					vb.Append(c.data[i])
+				}
+			}
+			tbl.cols[j] = &boolColumn{ColMeta: c.ColMeta, data: vb.NewBooleanArray()}
+			vb.Release()
+		case *intColumnBuilder:
+			vb := arrow.NewIntBuilder(nil)
+			vb.Reserve(stop - start)
+			for i := start; i < stop; i++ {
+				if c.IsNil(i) {
+					vb.AppendNull()
+				} else {
+					vb.Append(c.data[i])
+				}
+			}
+			tbl.cols[j] = &intColumn{ColMeta: c.ColMeta, data: vb.NewInt64Array()}
+			vb.Release()
+		case *uintColumnBuilder:
+			vb := arrow.NewUintBuilder(nil)
+			vb.Reserve(stop - start)
+			for i := start; i < stop; i++ {
+				if c.IsNil(i) {
+					vb.AppendNull()
+				} else {
+					vb.Append(c.data[i])
+				}
+			}
+			tbl.cols[j] = &uintColumn{ColMeta: c.ColMeta, data: vb.NewUint64Array()}
+			vb.Release()
+		case *floatColumnBuilder:
+			vb := arrow.NewFloatBuilder(nil)
+			vb.Reserve(stop - start)
+			for i := start; i < stop; i++ {
+				if c.IsNil(i) {
+					vb.AppendNull()
+				} else {
+					vb.Append(c.data[i])
+				}
+			}
+			tbl.cols[j] = &floatColumn{ColMeta: c.ColMeta, data: vb.NewFloat64Array()}
+			vb.Release()
+		case *stringColumnBuilder:
+			vb := arrow.NewStringBuilder(nil)
+			vb.Reserve(stop - start)
+			for i := start; i < stop; i++ {
+				if c.IsNil(i) {
+					vb.AppendNull()
+				} else {
+					vb.AppendString(c.data[i])
+				}
+			}
+			tbl.cols[j] = &stringColumn{ColMeta: c.ColMeta, data: vb.NewBinaryArray()}
+			vb.Release()
+		case *timeColumnBuilder:
+			vb := arrow.NewIntBuilder(nil)
+			vb.Reserve(stop - start)
+			for i := start; i < stop; i++ {
+				if c.IsNil(i) {
+					vb.AppendNull()
+				} else {
+					vb.Append(int64(c.data[i]))
+				}
+			}
+			tbl.cols[j] = &timeColumn{ColMeta: c.ColMeta, data: vb.NewInt64Array()}
+			vb.Release()
+		default:
+			PanicUnknownType(c.Meta().Type)
+		}
+	}
+	return tbl
+}
+
+// NewWriter creates a SpillWriter for a table with the key and columns.
+func (s *Spiller) NewWriter(key flux.GroupKey, cols []flux.ColMeta) (*SpillWriter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.config == nil {
+		return nil, errors.New("spilling to disk is not enabled")
+	}
+	if s.writing {
+		return nil, errors.New("spill file is already being written")
+	}
+	if s.f == nil {
+		f, err := ioutil.TempFile(s.config.Dir, "flux-spill-")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create spill file: %v", err)
+		}
+		s.f = f
+	}
+	s.writing = true
+	return &SpillWriter{
+		s:      s,
+		key:    key,
+		cols:   cols,
+		offset: s.size,
+		w:      bufio.NewWriter(s.f),
+	}, nil
+}
+
+// Close releases the reference of the owner to the spill file.
+func (s *Spiller) Close() {
+	s.release()
+}
+
+func (s *Spiller) retain() {
+	atomic.AddInt32(&s.refs, 1)
+}
+
+func (s *Spiller) release() {
+	if atomic.AddInt32(&s.refs, -1) != 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f != nil {
+		s.f.Close()
+		os.Remove(s.f.Name())
+		s.f = nil
+	}
+}
+
+// SpillWriter writes the rows of a single table to the file of a Spiller.
+type SpillWriter struct {
+	s      *Spiller
+	key    flux.GroupKey
+	cols   []flux.ColMeta
+	offset int64
+	size   int64
+	nrows  int
+	w      *bufio.Writer
+	buf    [binary.MaxVarintLen64]byte
+	err    error
+}
+
+// Write appends the rows of cr to the table.
+// The columns of cr must match the columns of the table.
+func (w *SpillWriter) Write(cr flux.ColReader) error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(cr.Cols()) != len(w.cols) {
+		return errors.New("spilled table columns do not match")
+	}
+	for start := 0; start < cr.Len(); start += spillChunkSize {
+		stop := start + spillChunkSize
+		if stop > cr.Len() {
+			stop = cr.Len()
+		}
+		if err := w.writeChunk(cr, start, stop); err != nil {
+			w.err = err
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteBuilder appends the rows of the builder to the table.
+// The rows are copied a few at a time so that little memory is needed to write them.
+func (w *SpillWriter) WriteBuilder(b *ColListTableBuilder) error {
+	for start := 0; start < b.nrows; start += spillChunkSize {
+		stop := start + spillChunkSize
+		if stop > b.nrows {
+			stop = b.nrows
+		}
+		cr := b.chunk(start, stop)
+		err := w.Write(cr)
+		cr.RefCount(-1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Table finishes writing and returns a table that reads the rows back from disk.
+func (w *SpillWriter) Table() (flux.Table, error) {
+	if w.err == nil && w.nrows == 0 {
+		// An empty table still has a chunk so that readers see its columns.
+		w.err = w.writeChunk(nil, 0, 0)
+	}
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	w.Close()
+	if w.err != nil {
+		return nil, fmt.Errorf("failed to write spill file: %v", w.err)
+	}
+	return &spilledTable{
+		s:      w.s,
+		key:    w.key,
+		cols:   w.cols,
+		offset: w.offset,
+		size:   w.size,
+		nrows:  w.nrows,
+	}, nil
+}
+
+// Close stops writing to the spill file.
+// The written data is discarded unless Table has been called.
+func (w *SpillWriter) Close() {
+	w.s.mu.Lock()
+	defer w.s.mu.Unlock()
+	if !w.s.writing {
+		return
+	}
+	w.s.writing = false
+	if w.err == nil {
+		w.s.size += w.size
+	} else if w.s.f != nil {
+		// Rewind the file so that the next writer starts after the last complete table.
+		w.s.f.Seek(w.s.size, io.SeekStart)
+	}
+}
+
+func (w *SpillWriter) write(p []byte) error {
+	n, err := w.w.Write(p)
+	w.size += int64(n)
+	return err
+}
+
+func (w *SpillWriter) writeUvarint(v uint64) error {
+	n := binary.PutUvarint(w.buf[:], v)
+	return w.write(w.buf[:n])
+}
+
+func (w *SpillWriter) writeUint64(v uint64) error {
+	binary.LittleEndian.PutUint64(w.buf[:8], v)
+	return w.write(w.buf[:8])
+}
+
+// writeBitmap writes a bit for each row, the bit is set when f returns true.
+func (w *SpillWriter) writeBitmap(n int, f func(i int) bool) error {
+	bitmap := make([]byte, (n+7)/8)
+	for i := 0; i < n; i++ {
+		if f(i) {
+			bitmap[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return w.write(bitmap)
+}
+
+// writeChunk writes the rows of cr in the range [start, stop).
+// Each column is written as a validity bitmap followed by its values.
+func (w *SpillWriter) writeChunk(cr flux.ColReader, start, stop int) error {
+	n := stop - start
+	if err := w.writeUvarint(uint64(n)); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	for j, c := range w.cols {
+		if cr.Cols()[j].Type != c.Type {
+			return fmt.Errorf("spilled column %q has type %v, got %v", c.Label, c.Type, cr.Cols()[j].Type)
+		}
+		var arr array.Interface
+		switch c.Type {
+		case flux.TBool:
+			arr = cr.Bools(j)
+		case flux.TInt:
+			arr = cr.Ints(j)
+		case flux.TUInt:
+			arr = cr.UInts(j)
+		case flux.TFloat:
+			arr = cr.Floats(j)
+		case flux.TString:
+			arr = cr.Strings(j)
+		case flux.TTime:
+			arr = cr.Times(j)
+		default:
+			PanicUnknownType(c.Type)
+		}
+		if err := w.writeBitmap(n, func(i int) bool {
+			return arr.IsValid(start + i)
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		switch arr := arr.(type) {
+		case *array.Boolean:
+			err = w.writeBitmap(n, func(i int) bool {
+				return arr.Value(start + i)
+			})
+		case *array.Int64:
+			for _, v := range arr.Int64Values()[start:stop] {
+				if err = w.writeUint64(uint64(v)); err != nil {
+					break
+				}
+			}
+		case *array.Uint64:
+			for _, v := range arr.Uint64Values()[start:stop] {
+				if err = w.writeUint64(v); err != nil {
+					break
+				}
+			}
+		case *array.Float64:
+			for _, v := range arr.Float64Values()[start:stop] {
+				if err = w.writeUint64(math.Float64bits(v)); err != nil {
+					break
+				}
+			}
+		case *array.Binary:
+			for i := start; i < stop; i++ {
+				v := arr.Value(i)
+				if err = w.writeUvarint(uint64(len(v))); err != nil {
+					break
+				}
+				if err = w.write(v); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	w.nrows += n
+	return nil
+}
+
+// spilledTable is a table whose data is stored in the file of a Spiller.
+type spilledTable struct {
+	s      *Spiller
+	key    flux.GroupKey
+	cols   []flux.ColMeta
+	offset int64
+	size   int64
+	nrows  int
+
+	refCount int32
+}
+
+func (t *spilledTable) Key() flux.GroupKey {
+	return t.key
+}
+
+func (t *spilledTable) Cols() []flux.ColMeta {
+	return t.cols
+}
+
+func (t *spilledTable) Empty() bool {
+	return t.nrows == 0
+}
+
+// RefCount keeps the spill file open while the table is in use.
+func (t *spilledTable) RefCount(n int) {
+	c := atomic.AddInt32(&t.refCount, int32(n))
+	if c > 0 && c == int32(n) {
+		t.s.retain()
+	} else if c == 0 && n < 0 {
+		t.s.release()
+	}
+}
+
+func (t *spilledTable) Do(f func(flux.ColReader) error) error {
+	r := t.reader()
+	for {
+		cr, err := r.next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = f(cr)
+		cr.RefCount(-1)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (t *spilledTable) reader() *spillReader {
+	return &spillReader{
+		t: t,
+		r: bufio.NewReader(io.NewSectionReader(t.s.f, t.offset, t.size)),
+	}
+}
+
+// spillReader reads the chunks of a spilled table one at a time.
+type spillReader struct {
+	t *spilledTable
+	r *bufio.Reader
+}
+
+// next reads the next chunk of the table.
+// The chunk must be released with RefCount(-1) when it is no longer used.
+func (r *spillReader) next() (*ColListTable, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	tbl := &ColListTable{
+		key:      r.t.key,
+		colMeta:  r.t.cols,
+		cols:     make([]column, len(r.t.cols)),
+		nrows:    int(n),
+		refCount: 1,
+	}
+	for j, c := range r.t.cols {
+		col, err := r.readColumn(c, int(n))
+		if err != nil {
+			tbl.RefCount(-1)
+			return nil, fmt.Errorf("failed to read spill file: %v", err)
+		}
+		tbl.cols[j] = col
+	}
+	return tbl, nil
+}
+
+func (r *spillReader) readBitmap(n int) ([]bool, error) {
+	bitmap := make([]byte, (n+7)/8)
+	if _, err := io.ReadFull(r.r, bitmap); err != nil {
+		return nil, err
+	}
+	vs := make([]bool, n)
+	for i := range vs {
+		vs[i] = bitmap[i/8]&(1<<uint(i%8)) != 0
+	}
+	return vs, nil
+}
+
+func (r *spillReader) readUint64s(n int) ([]uint64, error) {
+	buf := make([]byte, n*8)
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		return nil, err
+	}
+	vs := make([]uint64, n)
+	for i := range vs {
+		vs[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	return vs, nil
+}
+
+func (r *spillReader) readColumn(c flux.ColMeta, n int) (column, error) {
+	alloc := r.t.s.alloc
+	if n == 0 {
+		return emptyColumn(c, alloc), nil
+	}
+	valid, err := r.readBitmap(n)
+	if err != nil {
+		return nil, err
+	}
+	switch c.Type {
+	case flux.TBool:
+		vs, err := r.readBitmap(n)
+		if err != nil {
+			return nil, err
+		}
+		b := arrow.NewBoolBuilder(alloc)
+		b.AppendValues(vs, valid)
+		data := b.NewBooleanArray()
+		b.Release()
+		return &boolColumn{ColMeta: c, data: data}, nil
+	case flux.TInt, flux.TTime:
+		vs, err := r.readUint64s(n)
+		if err != nil {
+			return nil, err
+		}
+		ints := make([]int64, n)
+		for i, v := range vs {
+			ints[i] = int64(v)
+		}
+		b := arrow.NewIntBuilder(alloc)
+		b.AppendValues(ints, valid)
+		data := b.NewInt64Array()
+		b.Release()
+		if c.Type == flux.TTime {
+			return &timeColumn{ColMeta: c, data: data}, nil
+		}
+		return &intColumn{ColMeta: c, data: data}, nil
+	case flux.TUInt:
+		vs, err := r.readUint64s(n)
+		if err != nil {
+			return nil, err
+		}
+		b := arrow.NewUintBuilder(alloc)
+		b.AppendValues(vs, valid)
+		data := b.NewUint64Array()
+		b.Release()
+		return &uintColumn{ColMeta: c, data: data}, nil
+	case flux.TFloat:
+		vs, err := r.readUint64s(n)
+		if err != nil {
+			return nil, err
+		}
+		floats := make([]float64, n)
+		for i, v := range vs {
+			floats[i] = math.Float64frombits(v)
+		}
+		b := arrow.NewFloatBuilder(alloc)
+		b.AppendValues(floats, valid)
+		data := b.NewFloat64Array()
+		b.Release()
+		return &floatColumn{ColMeta: c, data: data}, nil
+	case flux.TString:
+		vs := make([]string, n)
+		for i := range vs {
+			l, err := binary.ReadUvarint(r.r)
+			if err != nil {
+				return nil, err
+			}
+			buf := make([]byte, l)
+			if _, err := io.ReadFull(r.r, buf); err != nil {
+				return nil, err
+			}
+			vs[i] = string(buf)
+		}
+		b := arrow.NewStringBuilder(alloc)
+		b.AppendStringValues(vs, valid)
+		data := b.NewBinaryArray()
+		b.Release()
+		return &stringColumn{ColMeta: c, data: data}, nil
+	default:
+		PanicUnknownType(c.Type)
+		return nil, nil
+	}
+}
+
+// emptyColumn returns a column without any rows.
+func emptyColumn(c flux.ColMeta, alloc *memory.Allocator) column {
+	switch c.Type {
+	case flux.TBool:
+		return &boolColumn{ColMeta: c, data: arrow.NewBool(nil, alloc)}
+	case flux.TInt:
+		return &intColumn{ColMeta: c, data: arrow.NewInt(nil, alloc)}
+	case flux.TUInt:
+		return &uintColumn{ColMeta: c, data: arrow.NewUint(nil, alloc)}
+	case flux.TFloat:
+		return &floatColumn{ColMeta: c, data: arrow.NewFloat(nil, alloc)}
+	case flux.TString:
+		return &stringColumn{ColMeta: c, data: arrow.NewString(nil, alloc)}
+	case flux.TTime:
+		return &timeColumn{ColMeta: c, data: arrow.NewInt(nil, alloc)}
+	default:
+		PanicUnknownType(c.Type)
+		return nil
+	}
+}
+
+// Merge returns a table that merges tables returned by Spill that are each sorted by the columns.
+// The rows are ordered the same way as ColListTableBuilder.Sort orders them.
+// Only one chunk of each table is held in memory at a time. When there is not
+// enough memory for a chunk of every table, some of the tables are first merged
+// into intermediate tables on disk.
+func (s *Spiller) Merge(key flux.GroupKey, tables []flux.Table, cols []string, desc bool) (flux.Table, error) {
+	runs := make([]*spilledTable, len(tables))
+	for i, tbl := range tables {
+		t, ok := tbl.(*spilledTable)
+		if !ok || t.s != s {
+			return nil, fmt.Errorf("cannot merge table that was not spilled to disk: %v", tbl.Key())
+		}
+		if i > 0 && !colsMatch(t.cols, runs[0].cols) {
+			return nil, errors.New("cannot merge tables with different columns")
+		}
+		runs[i] = t
+	}
+	if len(runs) == 0 {
+		return nil, errors.New("no tables to merge")
+	}
+	return &mergedTable{
+		key:  key,
+		runs: runs,
+		cols: cols,
+		desc: desc,
+	}, nil
+}
+
+// mergedTable is a table that merges sorted spilled tables as it is read.
+type mergedTable struct {
+	key  flux.GroupKey
+	runs []*spilledTable
+	cols []string
+	desc bool
+}
+
+func (t *mergedTable) Key() flux.GroupKey {
+	return t.key
+}
+
+func (t *mergedTable) Cols() []flux.ColMeta {
+	return t.runs[0].cols
+}
+
+func (t *mergedTable) Empty() bool {
+	for _, r := range t.runs {
+		if !r.Empty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *mergedTable) RefCount(n int) {
+	for _, r := range t.runs {
+		r.RefCount(n)
+	}
+}
+
+// mergeCursor is the position of a merge within a single run.
+type mergeCursor struct {
+	r   *spillReader
+	cr  *ColListTable
+	row int
+}
+
+// advance moves the cursor to the next row, reading the next chunk when needed.
+// The chunk is nil once the run has no more rows.
+func (c *mergeCursor) advance() error {
+	c.row++
+	for c.cr == nil || c.row >= c.cr.Len() {
+		if c.cr != nil {
+			c.cr.RefCount(-1)
+			c.cr = nil
+		}
+		cr, err := c.r.next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		c.cr, c.row = cr, 0
+	}
+	return nil
+}
+
+func (t *mergedTable) Do(f func(flux.ColReader) error) error {
+	runs, err := t.reduce()
+	if err != nil {
+		return err
+	}
+	return t.merge(runs, f)
+}
+
+// fanIn returns the number of runs that can be merged at once with the available memory.
+func (t *mergedTable) fanIn() int {
+	s := t.runs[0].s
+	n := int(s.alloc.Available()/MergeSize(0, t.Cols())) - 2
+	if n < 2 {
+		n = 2
+	}
+	return n
+}
+
+// reduce merges the runs into intermediate runs on disk
+// until they can all be merged at once.
+// The first runs are merged together so that the merge remains stable.
+func (t *mergedTable) reduce() ([]*spilledTable, error) {
+	runs := t.runs
+	fanIn := t.fanIn()
+	if len(runs) <= fanIn {
+		return runs, nil
+	}
+	s := runs[0].s
+	s.mergeMu.Lock()
+	defer s.mergeMu.Unlock()
+	for len(runs) > fanIn {
+		w, err := s.NewWriter(t.key, t.Cols())
+		if err != nil {
+			return nil, err
+		}
+		if err := t.merge(runs[:fanIn], w.Write); err != nil {
+			w.Close()
+			return nil, err
+		}
+		tbl, err := w.Table()
+		if err != nil {
+			return nil, err
+		}
+		runs = append([]*spilledTable{tbl.(*spilledTable)}, runs[fanIn:]...)
+	}
+	return runs, nil
+}
+
+// merge reads the runs and calls f with their rows in sorted order.
+func (t *mergedTable) merge(runs []*spilledTable, f func(flux.ColReader) error) error {
+	cols := t.Cols()
+	colIdxs := make([]int, 0, len(t.cols))
+	for _, label := range t.cols {
+		if j := ColIdx(label, cols); j >= 0 {
+			colIdxs = append(colIdxs, j)
+		}
+	}
+
+	cursors := make([]*mergeCursor, len(runs))
+	defer func() {
+		for _, c := range cursors {
+			if c != nil && c.cr != nil {
+				c.cr.RefCount(-1)
+			}
+		}
+	}()
+	for i, r := range runs {
+		cursors[i] = &mergeCursor{r: r.reader(), row: -1}
+		if err := cursors[i].advance(); err != nil {
+			return err
+		}
+	}
+
+	builder := NewColListTableBuilder(t.key, runs[0].s.alloc)
+	for _, c := range cols {
+		if _, err := builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+	flush := func() error {
+		tbl, err := builder.Table()
+		if err != nil {
+			return err
+		}
+		builder.ClearData()
+		tbl.RefCount(1)
+		defer tbl.RefCount(-1)
+		return f(tbl.(*ColListTable))
+	}
+	for {
+		min := -1
+		for i, c := range cursors {
+			if c.cr == nil {
+				continue
+			}
+			if min < 0 || t.less(colIdxs, c, cursors[min]) {
+				min = i
+			}
+		}
+		if min < 0 {
+			break
+		}
+		c := cursors[min]
+		for j := range cols {
+			if err := builder.AppendValue(j, ValueForRow(c.cr, c.row, j)); err != nil {
+				return err
+			}
+		}
+		if err := c.advance(); err != nil {
+			return err
+		}
+		if builder.NRows() == spillChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if builder.NRows() > 0 || t.Empty() {
+		return flush()
+	}
+	return nil
+}
+
+// less reports whether the current row of x sorts before the current row of y.
+// Ties keep the order of the runs so that the merge is stable.
+func (t *mergedTable) less(colIdxs []int, x, y *mergeCursor) bool {
+	for _, j := range colIdxs {
+		xv, yv := ValueForRow(x.cr, x.row, j), ValueForRow(y.cr, y.row, j)
+		if xv.IsNull() || yv.IsNull() {
+			if xv.IsNull() && yv.IsNull() {
+				continue
+			}
+			// Nulls are the lesser value in either order.
+			return xv.IsNull()
+		}
+		if c := compareValues(xv, yv); c != 0 {
+			if t.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+	}
+	return false
+}
+
+// compareValues compares two non-null values of the same column type.
+func compareValues(x, y values.Value) int {
+	switch x.Type() {
+	case semantic.Bool:
+		switch {
+		case x.Bool() == y.Bool():
+			return 0
+		case !x.Bool():
+			return -1
+		default:
+			return 1
+		}
+	case semantic.Int:
+		return compareOrdered(x.Int() < y.Int(), x.Int() > y.Int())
+	case semantic.UInt:
+		return compareOrdered(x.UInt() < y.UInt(), x.UInt() > y.UInt())
+	case semantic.Float:
+		return compareOrdered(x.Float() < y.Float(), x.Float() > y.Float())
+	case semantic.String:
+		return compareOrdered(x.Str() < y.Str(), x.Str() > y.Str())
+	case semantic.Time:
+		return compareOrdered(x.Time() < y.Time(), x.Time() > y.Time())
+	default:
+		return 0
+	}
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
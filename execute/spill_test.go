@@ -0,0 +1,323 @@
+package execute_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+)
+
+func newTestSpiller(t *testing.T, limit int64) (*execute.Spiller, *memory.Allocator, string) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "spill_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	alloc := &memory.Allocator{Limit: &limit}
+	return execute.NewSpiller(&execute.SpillConfig{Dir: dir}, alloc), alloc, dir
+}
+
+func spillFiles(t *testing.T, dir string) int {
+	t.Helper()
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(files)
+}
+
+func TestSpiller_Spill(t *testing.T) {
+	s, alloc, dir := newTestSpiller(t, 1<<30)
+	defer os.RemoveAll(dir)
+
+	in := &executetest.Table{
+		KeyCols: []string{"t0"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "b", Type: flux.TBool},
+			{Label: "i", Type: flux.TInt},
+			{Label: "u", Type: flux.TUInt},
+			{Label: "t0", Type: flux.TString},
+		},
+	}
+	// Use enough rows for the table to be read back in several chunks.
+	for i := 0; i < 2500; i++ {
+		row := []interface{}{execute.Time(i), float64(i) / 2, i%2 == 0, int64(-i), uint64(i), "a"}
+		if i%7 == 0 {
+			row[1], row[2], row[3], row[4] = nil, nil, nil, nil
+		}
+		in.Data = append(in.Data, row)
+	}
+	in.Normalize()
+
+	tbl, err := s.Spill(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("unexpected memory allocated after spilling: %d", got)
+	}
+
+	tbl.RefCount(1)
+	// The table can be read more than once.
+	for i := 0; i < 2; i++ {
+		chunks := 0
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			chunks++
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if chunks != 3 {
+			t.Errorf("unexpected number of chunks: %d", chunks)
+		}
+		got, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got.Normalize()
+		if !cmp.Equal(in, got) {
+			t.Fatalf("unexpected table -want/+got\n%s", cmp.Diff(in, got))
+		}
+	}
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("unexpected memory allocated after reading: %d", got)
+	}
+
+	s.Close()
+	if n := spillFiles(t, dir); n != 1 {
+		t.Fatalf("spill file removed while its table is in use, found %d files", n)
+	}
+	tbl.RefCount(-1)
+	if n := spillFiles(t, dir); n != 0 {
+		t.Fatalf("spill file was not removed, found %d files", n)
+	}
+}
+
+func TestSpiller_SpillEmpty(t *testing.T) {
+	s, _, dir := newTestSpiller(t, 1<<30)
+	defer os.RemoveAll(dir)
+	defer s.Close()
+
+	in := &executetest.Table{
+		KeyCols:   []string{"t0"},
+		KeyValues: []interface{}{"a"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "t0", Type: flux.TString},
+		},
+	}
+	in.Normalize()
+
+	tbl, err := s.Spill(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tbl.Empty() {
+		t.Error("expected spilled table to be empty")
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Normalize()
+	if !cmp.Equal(in, got) {
+		t.Fatalf("unexpected table -want/+got\n%s", cmp.Diff(in, got))
+	}
+}
+
+func TestSpiller_SpillBuilder(t *testing.T) {
+	s, alloc, dir := newTestSpiller(t, 1<<30)
+	defer os.RemoveAll(dir)
+	defer s.Close()
+
+	in := &executetest.Table{
+		KeyCols: []string{"t0"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "b", Type: flux.TBool},
+			{Label: "i", Type: flux.TInt},
+			{Label: "u", Type: flux.TUInt},
+			{Label: "t0", Type: flux.TString},
+		},
+	}
+	for i := 0; i < 1500; i++ {
+		row := []interface{}{execute.Time(i), float64(i), i%3 == 0, int64(i), uint64(i), "a"}
+		if i%5 == 0 {
+			row[1], row[2], row[3], row[4] = nil, nil, nil, nil
+		}
+		in.Data = append(in.Data, row)
+	}
+	in.Normalize()
+
+	b := execute.NewColListTableBuilder(in.Key(), alloc)
+	if err := execute.AddTableCols(in, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := execute.AppendTable(in, b); err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := s.SpillBuilder(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.NRows() != len(in.Data) {
+		t.Errorf("builder was modified, it has %d rows", b.NRows())
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Normalize()
+	if !cmp.Equal(in, got) {
+		t.Fatalf("unexpected table -want/+got\n%s", cmp.Diff(in, got))
+	}
+}
+
+func TestSpiller_Merge(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TInt},
+	}
+	testCases := []struct {
+		name string
+		desc bool
+		runs [][][]interface{}
+		want [][]interface{}
+	}{
+		{
+			name: "ascending",
+			runs: [][][]interface{}{
+				{{execute.Time(1), int64(1)}, {execute.Time(2), int64(4)}, {execute.Time(3), int64(7)}},
+				{{execute.Time(4), nil}, {execute.Time(5), int64(2)}, {execute.Time(6), int64(4)}},
+				{{execute.Time(7), int64(3)}},
+			},
+			want: [][]interface{}{
+				{execute.Time(4), nil},
+				{execute.Time(1), int64(1)},
+				{execute.Time(5), int64(2)},
+				{execute.Time(7), int64(3)},
+				{execute.Time(2), int64(4)},
+				{execute.Time(6), int64(4)},
+				{execute.Time(3), int64(7)},
+			},
+		},
+		{
+			name: "descending",
+			desc: true,
+			runs: [][][]interface{}{
+				{{execute.Time(1), int64(7)}, {execute.Time(2), int64(4)}, {execute.Time(3), int64(1)}},
+				{{execute.Time(4), nil}, {execute.Time(5), int64(5)}},
+				{},
+			},
+			want: [][]interface{}{
+				{execute.Time(4), nil},
+				{execute.Time(1), int64(7)},
+				{execute.Time(5), int64(5)},
+				{execute.Time(2), int64(4)},
+				{execute.Time(3), int64(1)},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s, _, dir := newTestSpiller(t, 1<<30)
+			defer os.RemoveAll(dir)
+			defer s.Close()
+
+			var key flux.GroupKey
+			runs := make([]flux.Table, len(tc.runs))
+			for i, data := range tc.runs {
+				in := &executetest.Table{ColMeta: cols, Data: data}
+				in.Normalize()
+				key = in.Key()
+				tbl, err := s.Spill(in)
+				if err != nil {
+					t.Fatal(err)
+				}
+				runs[i] = tbl
+			}
+			tbl, err := s.Merge(key, runs, []string{"_value"}, tc.desc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got.Normalize()
+			want := &executetest.Table{ColMeta: cols, Data: tc.want}
+			want.Normalize()
+			if !cmp.Equal(want, got) {
+				t.Fatalf("unexpected table -want/+got\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestSpiller_ShouldSpill(t *testing.T) {
+	in := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{execute.Time(2), 2.0},
+		},
+	}
+	in.Normalize()
+
+	for _, tc := range []struct {
+		name    string
+		limit   int64
+		config  *execute.SpillConfig
+		enabled bool
+		want    bool
+	}{
+		{
+			name:    "enough memory",
+			limit:   1024,
+			config:  &execute.SpillConfig{},
+			enabled: true,
+		},
+		{
+			name:    "low memory",
+			limit:   32,
+			config:  &execute.SpillConfig{},
+			enabled: true,
+			want:    true,
+		},
+		{
+			name:  "disabled",
+			limit: 32,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			alloc := &memory.Allocator{Limit: &tc.limit}
+			s := execute.NewSpiller(tc.config, alloc)
+			defer s.Close()
+			if got := s.Enabled(); got != tc.enabled {
+				t.Errorf("unexpected enabled: got %v want %v", got, tc.enabled)
+			}
+			b := execute.NewColListTableBuilder(in.Key(), alloc)
+			if err := in.Do(func(cr flux.ColReader) error {
+				if got := s.ShouldSpill(execute.AppendSize(b, cr)); got != tc.want {
+					t.Errorf("unexpected should spill: got %v want %v", got, tc.want)
+				}
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
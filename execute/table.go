@@ -717,7 +717,7 @@ func (b *ColListTableBuilder) AppendBools(j int, vs *array.Boolean) error {
 			return err
 		}
 		if vs.IsNull(i) {
-			if err := b.SetNil(b.nrows, j); err != nil {
+			if err := b.SetNil(b.nrows-1, j); err != nil {
 				return err
 			}
 		}
@@ -912,7 +912,7 @@ func (b *ColListTableBuilder) SetString(i int, j int, value string) error {
 	if err := b.checkCol(j, flux.TString); err != nil {
 		return err
 	}
-	b.cols[j].(*stringColumnBuilder).data[i] = value
+	b.alloc.SetString(b.cols[j].(*stringColumnBuilder).data, i, value)
 	b.cols[j].SetNil(i, false)
 	return nil
 }
@@ -1213,21 +1213,29 @@ func (b *ColListTableBuilder) SliceColumns(start, stop int) error {
 
 		case flux.TBool:
 			col := b.cols[i].(*boolColumnBuilder)
+			b.alloc.Free(start, boolSize)
 			col.data = col.data[start:stop]
 		case flux.TInt:
 			col := b.cols[i].(*intColumnBuilder)
+			b.alloc.Free(start, int64Size)
 			col.data = col.data[start:stop]
 		case flux.TUInt:
 			col := b.cols[i].(*uintColumnBuilder)
+			b.alloc.Free(start, uint64Size)
 			col.data = col.data[start:stop]
 		case flux.TFloat:
 			col := b.cols[i].(*floatColumnBuilder)
+			b.alloc.Free(start, float64Size)
 			col.data = col.data[start:stop]
 		case flux.TString:
 			col := b.cols[i].(*stringColumnBuilder)
+			b.alloc.FreeStrings(col.data[:start]...)
+			b.alloc.FreeStrings(col.data[stop:]...)
+			b.alloc.Free(start, stringSize)
 			col.data = col.data[start:stop]
 		case flux.TTime:
 			col := b.cols[i].(*timeColumnBuilder)
+			b.alloc.Free(start, timeSize)
 			col.data = col.data[start:stop]
 		default:
 			panic(fmt.Errorf("unexpected column type %v", c.Meta().Type))
@@ -1514,8 +1522,9 @@ type boolColumnBuilder struct {
 }
 
 func (c *boolColumnBuilder) Clear() {
-	c.alloc.Free(len(c.data), boolSize)
-	c.data = c.data[0:0]
+	c.alloc.Free(cap(c.data), boolSize)
+	c.data = nil
+	c.nils = make(map[int]bool)
 }
 
 func (c *boolColumnBuilder) Copy() column {
@@ -1595,8 +1604,9 @@ type intColumnBuilder struct {
 }
 
 func (c *intColumnBuilder) Clear() {
-	c.alloc.Free(len(c.data), int64Size)
-	c.data = c.data[0:0]
+	c.alloc.Free(cap(c.data), int64Size)
+	c.data = nil
+	c.nils = make(map[int]bool)
 }
 
 func (c *intColumnBuilder) Copy() column {
@@ -1673,8 +1683,9 @@ type uintColumnBuilder struct {
 }
 
 func (c *uintColumnBuilder) Clear() {
-	c.alloc.Free(len(c.data), uint64Size)
-	c.data = c.data[0:0]
+	c.alloc.Free(cap(c.data), uint64Size)
+	c.data = nil
+	c.nils = make(map[int]bool)
 }
 
 func (c *uintColumnBuilder) Copy() column {
@@ -1752,8 +1763,9 @@ type floatColumnBuilder struct {
 }
 
 func (c *floatColumnBuilder) Clear() {
-	c.alloc.Free(len(c.data), float64Size)
-	c.data = c.data[0:0]
+	c.alloc.Free(cap(c.data), float64Size)
+	c.data = nil
+	c.nils = make(map[int]bool)
 }
 
 func (c *floatColumnBuilder) Copy() column {
@@ -1831,8 +1843,10 @@ type stringColumnBuilder struct {
 }
 
 func (c *stringColumnBuilder) Clear() {
-	c.alloc.Free(len(c.data), stringSize)
-	c.data = c.data[0:0]
+	c.alloc.FreeStrings(c.data...)
+	c.alloc.Free(cap(c.data), stringSize)
+	c.data = nil
+	c.nils = make(map[int]bool)
 }
 
 func (c *stringColumnBuilder) Copy() column {
@@ -1917,8 +1931,9 @@ type timeColumnBuilder struct {
 }
 
 func (c *timeColumnBuilder) Clear() {
-	c.alloc.Free(len(c.data), timeSize)
-	c.data = c.data[0:0]
+	c.alloc.Free(cap(c.data), timeSize)
+	c.data = nil
+	c.nils = make(map[int]bool)
 }
 
 func (c *timeColumnBuilder) Copy() column {
@@ -1969,7 +1984,7 @@ type TableBuilderCache interface {
 
 type tableBuilderCache struct {
 	tables *GroupLookup
-	alloc  *memory.Allocator
+	alloc  *Allocator
 
 	triggerSpec plan.TriggerSpec
 }
@@ -1977,7 +1992,7 @@ type tableBuilderCache struct {
 func NewTableBuilderCache(a *memory.Allocator) *tableBuilderCache {
 	return &tableBuilderCache{
 		tables: NewGroupLookup(),
-		alloc:  a,
+		alloc:  &Allocator{Allocator: a},
 	}
 }
 
@@ -2011,13 +2026,14 @@ func (d *tableBuilderCache) lookupState(key flux.GroupKey) (tableState, bool) {
 func (d *tableBuilderCache) TableBuilder(key flux.GroupKey) (TableBuilder, bool) {
 	b, ok := d.lookupState(key)
 	if !ok {
-		builder := NewColListTableBuilder(key, d.alloc)
+		builder := NewColListTableBuilder(key, d.alloc.Allocator)
 		t := NewTriggerFromSpec(d.triggerSpec)
 		b = tableState{
 			builder: builder,
 			trigger: t,
 		}
 		d.tables.Set(key, b)
+		d.alloc.account(groupKeySize(key), 1)
 	}
 	return b.builder, !ok
 }
@@ -2039,9 +2055,21 @@ func (d *tableBuilderCache) ExpireTable(key flux.GroupKey) {
 	b, ok := d.tables.Delete(key)
 	if ok {
 		b.(tableState).builder.ClearData()
+		d.alloc.Free(groupKeySize(key), 1)
 	}
 }
 
+// groupKeySize returns the number of bytes held by the string values of the group key.
+func groupKeySize(key flux.GroupKey) int {
+	n := 0
+	for j, c := range key.Cols() {
+		if c.Type == flux.TString && !key.IsNull(j) {
+			n += len(key.ValueString(j))
+		}
+	}
+	return n
+}
+
 func (d *tableBuilderCache) ForEach(f func(flux.GroupKey)) {
 	d.tables.Range(func(key flux.GroupKey, value interface{}) {
 		f(key)
@@ -2,12 +2,16 @@ package execute_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
 )
 
@@ -253,3 +257,158 @@ func TestColListTable_SetNil(t *testing.T) {
 		t.Fatalf("unexpected error: %s", err)
 	}
 }
+
+func TestColListTableBuilder_AppendBools(t *testing.T) {
+	key := execute.NewGroupKey(nil, nil)
+	tb := execute.NewColListTableBuilder(key, &memory.Allocator{})
+	idx, _ := tb.AddCol(flux.ColMeta{
+		Label: execute.DefaultValueColLabel,
+		Type:  flux.TBool,
+	})
+
+	b := arrow.NewBoolBuilder(nil)
+	b.Append(true)
+	b.AppendNull()
+	b.Append(false)
+	vs := b.NewBooleanArray()
+	b.Release()
+	defer vs.Release()
+	if err := tb.AppendBools(idx, vs); err != nil {
+		t.Fatal(err)
+	}
+
+	tbl, err := tb.Table()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The null is set on the row that was appended for it.
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i, want := range []bool{false, true, false} {
+			if got := cr.Bools(idx).IsNull(i); got != want {
+				t.Errorf("unexpected null at row %d -want/+got\n\t- %v\n\t+ %v", i, want, got)
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestColListTableBuilder_ClearData(t *testing.T) {
+	alloc := &memory.Allocator{}
+	key := execute.NewGroupKey(nil, nil)
+	tb := execute.NewColListTableBuilder(key, alloc)
+	idx, _ := tb.AddCol(flux.ColMeta{
+		Label: execute.DefaultValueColLabel,
+		Type:  flux.TFloat,
+	})
+	for i := 0; i < 10; i++ {
+		_ = tb.AppendFloat(idx, float64(i))
+	}
+	_ = tb.AppendNil(idx)
+
+	// Clearing frees the whole capacity of the columns, not only their length.
+	tb.ClearData()
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("unexpected allocated memory after clear: %d", got)
+	}
+
+	// Rows appended after a clear do not inherit the nulls of the cleared rows.
+	for i := 0; i < 11; i++ {
+		_ = tb.AppendFloat(idx, float64(i))
+	}
+	tbl, err := tb.Table()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if got := cr.Floats(idx).NullN(); got != 0 {
+			t.Errorf("unexpected nulls after clear: %d", got)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestColListTableBuilder_StringMemory(t *testing.T) {
+	alloc := &memory.Allocator{}
+	key := execute.NewGroupKey(nil, nil)
+	tb := execute.NewColListTableBuilder(key, alloc)
+
+	idx, _ := tb.AddCol(flux.ColMeta{
+		Label: execute.DefaultValueColLabel,
+		Type:  flux.TString,
+	})
+
+	// The bytes of each string are accounted for along with its header.
+	_ = tb.AppendString(idx, strings.Repeat("a", 1000))
+	_ = tb.AppendString(idx, strings.Repeat("b", 1000))
+	_ = tb.AppendString(idx, strings.Repeat("c", 1000))
+	headers := alloc.Allocated() - 3000
+	if headers <= 0 {
+		t.Fatalf("string bytes were not accounted for, allocated %d bytes", alloc.Allocated())
+	}
+
+	// Replacing a string accounts for the difference in size.
+	_ = tb.SetString(0, idx, "a")
+	if got, want := alloc.Allocated(), headers+2001; got != want {
+		t.Errorf("unexpected allocated memory after set -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	_ = tb.SetString(0, idx, strings.Repeat("a", 2000))
+	if got, want := alloc.Allocated(), headers+4000; got != want {
+		t.Errorf("unexpected allocated memory after set -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// Slicing releases the strings that are dropped.
+	if err := tb.SliceColumns(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := alloc.Allocated(); got >= headers+1000 {
+		t.Errorf("sliced strings were not freed, allocated %d bytes", got)
+	}
+
+	tb.ClearData()
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("unexpected allocated memory after clear: %d", got)
+	}
+}
+
+func TestTableBuilderCache_GroupKeyMemory(t *testing.T) {
+	alloc := &memory.Allocator{}
+	cache := execute.NewTableBuilderCache(alloc)
+	cache.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+	key := execute.NewGroupKey(
+		[]flux.ColMeta{
+			{Label: "t0", Type: flux.TString},
+			{Label: "t1", Type: flux.TString},
+			{Label: "n", Type: flux.TInt},
+		},
+		[]values.Value{
+			values.NewString(strings.Repeat("a", 100)),
+			values.NewNull(semantic.String),
+			values.NewInt(1),
+		},
+	)
+	if _, created := cache.TableBuilder(key); !created {
+		t.Fatal("expected a new builder")
+	}
+	if got, want := alloc.Allocated(), int64(100); got != want {
+		t.Errorf("unexpected allocated memory for group key -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// Looking up an existing builder does not account for the key again.
+	if _, created := cache.TableBuilder(key); created {
+		t.Fatal("expected an existing builder")
+	}
+	if got, want := alloc.Allocated(), int64(100); got != want {
+		t.Errorf("unexpected allocated memory for group key -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	cache.ExpireTable(key)
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("unexpected allocated memory after expire: %d", got)
+	}
+}
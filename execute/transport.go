@@ -1,8 +1,10 @@
 package execute
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/influxdata/flux"
 )
@@ -14,11 +16,24 @@ type Transport interface {
 }
 
 // consecutiveTransport implements Transport by transporting data consecutively to the downstream Transformation.
+//
+// The messages are queued in a bounded queue. When the queue is full, a source sending
+// to the transport waits in Run for the queue to have room. The transports of a
+// transformation sending to the transport are not scheduled until the queue has room,
+// so the worker of the dispatcher is free to process the messages of other transports.
 type consecutiveTransport struct {
+	ctx        context.Context
 	dispatcher Dispatcher
 
 	t        Transformation
-	messages MessageQueue
+	messages *boundedMessageQueue
+
+	// fromSource is set when the messages are sent by a source.
+	fromSource bool
+	// upstream are the transports of the node that sends to this transport
+	// and downstream are the transports of the nodes this transport sends to.
+	upstream   []*consecutiveTransport
+	downstream []*consecutiveTransport
 
 	finished chan struct{}
 	errMu    sync.Mutex
@@ -26,15 +41,81 @@ type consecutiveTransport struct {
 
 	schedulerState int32
 	inflight       int32
+
+	// profile records the statistics of the node of the transformation.
+	profile     *nodeProfile
+	scheduledAt int64
 }
 
-func newConsecutiveTransport(dispatcher Dispatcher, t Transformation) *consecutiveTransport {
+func newConsecutiveTransport(ctx context.Context, dispatcher Dispatcher, t Transformation, profile *nodeProfile, queueSize int) *consecutiveTransport {
 	return &consecutiveTransport{
+		ctx:        ctx,
 		dispatcher: dispatcher,
 		t:          t,
-		// TODO(nathanielc): Have planner specify message queue initial buffer size.
-		messages: newMessageQueue(64),
-		finished: make(chan struct{}),
+		profile:    profile,
+		messages:   newMessageQueue(queueSize),
+		finished:   make(chan struct{}),
+	}
+}
+
+// connect makes the transport receive the messages sent by the upstream transports.
+func (t *consecutiveTransport) connect(upstream []*consecutiveTransport) {
+	t.upstream = upstream
+	for _, u := range upstream {
+		u.downstream = append(u.downstream, t)
+	}
+}
+
+// waitForRoom waits for the message queue to have room when the messages are sent by a source.
+// It returns early if the transport has finished or the query has been canceled.
+func (t *consecutiveTransport) waitForRoom() error {
+	if !t.fromSource {
+		return nil
+	}
+	select {
+	case <-t.messages.Room():
+		return nil
+	case <-t.finished:
+		return nil
+	case <-t.ctx.Done():
+		return t.ctx.Err()
+	}
+}
+
+// full reports whether the message queue is full and the transport is still processing messages.
+func (t *consecutiveTransport) full() bool {
+	if !t.messages.Full() {
+		return false
+	}
+	select {
+	case <-t.finished:
+		return false
+	default:
+		return true
+	}
+}
+
+// blocked reports whether any of the downstream transports are full,
+// in which case the transport must not process its messages.
+func (t *consecutiveTransport) blocked() bool {
+	for _, d := range t.downstream {
+		if d.full() {
+			return true
+		}
+	}
+	return false
+}
+
+// wakeUpstream schedules the upstream transports with messages left to process
+// once the transport is no longer full.
+func (t *consecutiveTransport) wakeUpstream() {
+	if t.full() {
+		return
+	}
+	for _, u := range t.upstream {
+		if atomic.LoadInt32(&u.inflight) > 0 {
+			u.schedule()
+		}
 	}
 }
 
@@ -55,6 +136,9 @@ func (t *consecutiveTransport) Finished() <-chan struct{} {
 }
 
 func (t *consecutiveTransport) RetractTable(id DatasetID, key flux.GroupKey) error {
+	if err := t.waitForRoom(); err != nil {
+		return err
+	}
 	select {
 	case <-t.finished:
 		return t.err()
@@ -68,6 +152,9 @@ func (t *consecutiveTransport) RetractTable(id DatasetID, key flux.GroupKey) err
 }
 
 func (t *consecutiveTransport) Process(id DatasetID, tbl flux.Table) error {
+	if err := t.waitForRoom(); err != nil {
+		return err
+	}
 	select {
 	case <-t.finished:
 		return t.err()
@@ -75,12 +162,15 @@ func (t *consecutiveTransport) Process(id DatasetID, tbl flux.Table) error {
 	}
 	t.pushMsg(&processMsg{
 		srcMessage: srcMessage(id),
-		table:      tbl,
+		table:      t.profile.countIn(tbl),
 	})
 	return nil
 }
 
 func (t *consecutiveTransport) UpdateWatermark(id DatasetID, time Time) error {
+	if err := t.waitForRoom(); err != nil {
+		return err
+	}
 	select {
 	case <-t.finished:
 		return t.err()
@@ -94,6 +184,9 @@ func (t *consecutiveTransport) UpdateWatermark(id DatasetID, time Time) error {
 }
 
 func (t *consecutiveTransport) UpdateProcessingTime(id DatasetID, time Time) error {
+	if err := t.waitForRoom(); err != nil {
+		return err
+	}
 	select {
 	case <-t.finished:
 		return t.err()
@@ -107,6 +200,8 @@ func (t *consecutiveTransport) UpdateProcessingTime(id DatasetID, time Time) err
 }
 
 func (t *consecutiveTransport) Finish(id DatasetID, err error) {
+	// The finish message is sent even if the query has been canceled.
+	_ = t.waitForRoom()
 	select {
 	case <-t.finished:
 		return
@@ -134,6 +229,7 @@ const (
 // schedule indicates that there is work available to schedule.
 func (t *consecutiveTransport) schedule() {
 	if t.tryTransition(idle, running) {
+		atomic.StoreInt64(&t.scheduledAt, time.Now().UnixNano())
 		t.dispatcher.Schedule(t.processMessages)
 	}
 }
@@ -149,13 +245,28 @@ func (t *consecutiveTransport) transition(new int32) {
 }
 
 func (t *consecutiveTransport) processMessages(throughput int) {
+	start := time.Now()
+	t.profile.addDispatch(time.Duration(start.UnixNano() - atomic.LoadInt64(&t.scheduledAt)))
+	t.profile.startSpan()
+	defer func() {
+		t.profile.addProcess(time.Since(start))
+	}()
+
 PROCESS:
 	i := 0
-	for m := t.messages.Pop(); m != nil; m = t.messages.Pop() {
+	for !t.blocked() {
+		m := t.messages.Pop()
+		if m == nil {
+			break
+		}
 		atomic.AddInt32(&t.inflight, -1)
+		t.wakeUpstream()
 		if f, err := processMessage(t.t, m); err != nil || f {
 			// Set the error if there was any
 			t.setErr(err)
+			if err != nil {
+				t.profile.setErr(err)
+			}
 
 			// Transition to the finished state.
 			if t.tryTransition(running, finished) {
@@ -165,6 +276,8 @@ PROCESS:
 				}
 				// We are finished
 				close(t.finished)
+				t.wakeUpstream()
+				t.profile.done()
 				return
 			}
 		}
@@ -179,9 +292,9 @@ PROCESS:
 	}
 
 	t.transition(idle)
-	// Check if more messages arrived after the above loop finished.
-	// This check must happen in the idle state.
-	if atomic.LoadInt32(&t.inflight) > 0 {
+	// Check if more messages arrived or the downstream transports made room
+	// after the above loop finished. This check must happen in the idle state.
+	if atomic.LoadInt32(&t.inflight) > 0 && !t.blocked() {
 		if t.tryTransition(idle, running) {
 			goto PROCESS
 		} // else we have already been scheduled again, we can return
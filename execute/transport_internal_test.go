@@ -0,0 +1,151 @@
+package execute
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/memory"
+	"go.uber.org/zap/zaptest"
+)
+
+// watermarkTransformation counts the watermarks it receives and forwards them to next.
+// It waits for release to be closed before returning from UpdateWatermark.
+type watermarkTransformation struct {
+	received int32
+	release  chan struct{}
+	next     Transformation
+	finished chan struct{}
+}
+
+func newWatermarkTransformation(release chan struct{}, next Transformation) *watermarkTransformation {
+	return &watermarkTransformation{
+		release:  release,
+		next:     next,
+		finished: make(chan struct{}),
+	}
+}
+
+func (t *watermarkTransformation) RetractTable(id DatasetID, key flux.GroupKey) error { return nil }
+func (t *watermarkTransformation) Process(id DatasetID, tbl flux.Table) error         { return nil }
+func (t *watermarkTransformation) UpdateProcessingTime(id DatasetID, ts Time) error   { return nil }
+
+func (t *watermarkTransformation) UpdateWatermark(id DatasetID, ts Time) error {
+	atomic.AddInt32(&t.received, 1)
+	if t.release != nil {
+		<-t.release
+	}
+	if t.next != nil {
+		return t.next.UpdateWatermark(id, ts)
+	}
+	return nil
+}
+
+func (t *watermarkTransformation) Finish(id DatasetID, err error) {
+	if t.next != nil {
+		t.next.Finish(id, err)
+	}
+	close(t.finished)
+}
+
+func newTestTransport(ctx context.Context, d Dispatcher, t Transformation, queueSize int) *consecutiveTransport {
+	profile := &nodeProfile{
+		ctx:     ctx,
+		alloc:   &memory.Allocator{},
+		pending: 1,
+	}
+	return newConsecutiveTransport(ctx, d, t, profile, queueSize)
+}
+
+// waitFor waits for the condition to hold or fails the test after a second.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestConsecutiveTransport_SourceBackpressure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newPoolDispatcher(10, zaptest.NewLogger(t))
+	d.Start(2, ctx)
+	defer d.Stop()
+
+	release := make(chan struct{})
+	tr := newWatermarkTransformation(release, nil)
+	transport := newTestTransport(ctx, d, tr, 2)
+	transport.fromSource = true
+
+	var sent int32
+	go func() {
+		for i := 0; i < 10; i++ {
+			if err := transport.UpdateWatermark(DatasetID{}, Time(i)); err != nil {
+				t.Error(err)
+				return
+			}
+			atomic.AddInt32(&sent, 1)
+		}
+		transport.Finish(DatasetID{}, nil)
+	}()
+
+	// The first message is being processed and the next two are queued.
+	waitFor(t, func() bool { return atomic.LoadInt32(&sent) == 3 })
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt32(&sent); got != 3 {
+		t.Fatalf("source sent %d messages, expected it to wait after 3", got)
+	}
+
+	close(release)
+	<-transport.Finished()
+	if got := atomic.LoadInt32(&tr.received); got != 10 {
+		t.Errorf("unexpected number of messages received: %d", got)
+	}
+}
+
+func TestConsecutiveTransport_TransformationBackpressure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newPoolDispatcher(10, zaptest.NewLogger(t))
+	d.Start(2, ctx)
+	defer d.Stop()
+
+	release := make(chan struct{})
+	slow := newWatermarkTransformation(release, nil)
+	downstream := newTestTransport(ctx, d, slow, 1)
+	fast := newWatermarkTransformation(nil, downstream)
+	upstream := newTestTransport(ctx, d, fast, 1)
+	downstream.connect([]*consecutiveTransport{upstream})
+
+	// The upstream transport does not block the caller
+	// even though its queue is full.
+	for i := 0; i < 10; i++ {
+		if err := upstream.UpdateWatermark(DatasetID{}, Time(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	upstream.Finish(DatasetID{}, nil)
+
+	// The first message is being processed by the slow transformation
+	// and the second is queued, so the upstream transport waits.
+	waitFor(t, func() bool { return atomic.LoadInt32(&fast.received) == 2 })
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt32(&fast.received); got != 2 {
+		t.Fatalf("upstream transport processed %d messages, expected it to wait after 2", got)
+	}
+
+	close(release)
+	<-upstream.Finished()
+	<-downstream.Finished()
+	if got := atomic.LoadInt32(&slow.received); got != 10 {
+		t.Errorf("unexpected number of messages received: %d", got)
+	}
+}
@@ -1,19 +1,35 @@
 package execute
 
+import (
+	"time"
+
+	"github.com/influxdata/flux/values"
+)
+
 type Window struct {
 	Every  Duration
 	Period Duration
 	Offset Duration
+	// Location is the time zone used to align window boundaries.
+	// Windows that are a whole number of days or months align to local midnight.
+	// A nil location is UTC.
+	Location *time.Location
 }
 
 // NewWindow creates a window with the given parameters,
 // and normalizes the offset to a small positive duration.
+// Offsets are only normalized when both every and offset are exact durations,
+// a calendar duration in either is used as is.
 func NewWindow(every, period, offset Duration) Window {
 	// Normalize the offset to a small positive duration
-	if offset < 0 {
-		offset += every * ((offset / -every) + 1)
-	} else if offset > every {
-		offset -= every * (offset / every)
+	if every.Months() == 0 && offset.Months() == 0 && every.Nanoseconds() > 0 {
+		e, o := every.Nanoseconds(), offset.Nanoseconds()
+		if o < 0 {
+			o += e * ((o / -e) + 1)
+		} else if o > e {
+			o -= e * (o / e)
+		}
+		offset = values.ConvertDuration(time.Duration(o))
 	}
 
 	return Window{
@@ -27,19 +43,7 @@ func NewWindow(every, period, offset Duration) Window {
 // that contains the given time t.  For underlapping windows that
 // do not contain time t, the window directly after time t will be returned.
 func (w Window) GetEarliestBounds(t Time) Bounds {
-	// translate to not-offset coordinate
-	t = t.Add(-w.Offset)
-
-	stop := t.Truncate(w.Every).Add(w.Every)
-
-	// translate to offset coordinate
-	stop = stop.Add(w.Offset)
-
-	start := stop.Add(-w.Period)
-	return Bounds{
-		Start: start,
-		Stop:  stop,
-	}
+	return w.boundsAt(w.truncate(t), 1)
 }
 
 // GetOverlappingBounds returns a slice of bounds for each window
@@ -49,15 +53,41 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 		return []Bounds{}
 	}
 
-	c := (b.Duration() / w.Every) + (w.Period / w.Every)
+	// Calendar durations do not have a fixed length so this is only an estimate.
+	every := w.Every.Duration()
+	c := (b.Duration().Duration() / every) + (w.Period.Duration() / every)
 	bs := make([]Bounds, 0, c)
 
-	bi := w.GetEarliestBounds(b.Start)
-	for bi.Start < b.Stop {
+	// Each window is computed from the same aligned time so that
+	// calendar arithmetic does not accumulate drift between windows.
+	base := w.truncate(b.Start)
+	for i := int64(1); ; i++ {
+		bi := w.boundsAt(base, i)
+		if bi.Start >= b.Stop {
+			break
+		}
 		bs = append(bs, bi)
-		bi.Start = bi.Start.Add(w.Every)
-		bi.Stop = bi.Stop.Add(w.Every)
 	}
 
 	return bs
 }
+
+// truncate returns the window boundary at or before t, without the offset applied.
+func (w Window) truncate(t Time) Time {
+	// translate to not-offset coordinate
+	return t.AddIn(w.Offset.Neg(), w.Location).TruncateIn(w.Every, w.Location)
+}
+
+// boundsAt returns the bounds of the nth window that stops after the truncated time base.
+func (w Window) boundsAt(base Time, n int64) Bounds {
+	stop := base.AddIn(w.Every.Mul(n), w.Location)
+
+	// translate to offset coordinate
+	stop = stop.AddIn(w.Offset, w.Location)
+
+	start := stop.AddIn(w.Period.Neg(), w.Location)
+	return Bounds{
+		Start: start,
+		Stop:  stop,
+	}
+}
@@ -6,43 +6,44 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/values"
 )
 
 func TestNewWindow(t *testing.T) {
 	want := execute.Window{
-		Every:  execute.Duration(time.Minute),
-		Period: execute.Duration(time.Minute),
-		Offset: execute.Duration(time.Second),
+		Every:  values.ConvertDuration(time.Minute),
+		Period: values.ConvertDuration(time.Minute),
+		Offset: values.ConvertDuration(time.Second),
 	}
-	got := execute.NewWindow(execute.Duration(time.Minute), execute.Duration(time.Minute), execute.Duration(time.Second))
+	got := execute.NewWindow(values.ConvertDuration(time.Minute), values.ConvertDuration(time.Minute), values.ConvertDuration(time.Second))
 	if !cmp.Equal(want, got) {
 		t.Errorf("window different; -want/+got:\n%v\n", cmp.Diff(want, got))
 	}
 
 	// offset larger than "every" duration will be normalized
 	want = execute.Window{
-		Every:  execute.Duration(time.Minute),
-		Period: execute.Duration(time.Minute),
-		Offset: execute.Duration(30 * time.Second),
+		Every:  values.ConvertDuration(time.Minute),
+		Period: values.ConvertDuration(time.Minute),
+		Offset: values.ConvertDuration(30 * time.Second),
 	}
 	got = execute.NewWindow(
-		execute.Duration(time.Minute),
-		execute.Duration(time.Minute),
-		execute.Duration(2*time.Minute+30*time.Second))
+		values.ConvertDuration(time.Minute),
+		values.ConvertDuration(time.Minute),
+		values.ConvertDuration(2*time.Minute+30*time.Second))
 	if !cmp.Equal(want, got) {
 		t.Errorf("window different; -want/+got:\n%v\n", cmp.Diff(want, got))
 	}
 
 	// Negative offset will be normalized
 	want = execute.Window{
-		Every:  execute.Duration(time.Minute),
-		Period: execute.Duration(time.Minute),
-		Offset: execute.Duration(30 * time.Second),
+		Every:  values.ConvertDuration(time.Minute),
+		Period: values.ConvertDuration(time.Minute),
+		Offset: values.ConvertDuration(30 * time.Second),
 	}
 	got = execute.NewWindow(
-		execute.Duration(time.Minute),
-		execute.Duration(time.Minute),
-		-execute.Duration(2*time.Minute+30*time.Second))
+		values.ConvertDuration(time.Minute),
+		values.ConvertDuration(time.Minute),
+		values.ConvertDuration(-(2*time.Minute + 30*time.Second)))
 	if !cmp.Equal(want, got) {
 		t.Errorf("window different; -want/+got:\n%v\n", cmp.Diff(want, got))
 	}
@@ -58,9 +59,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "simple",
 			w: execute.NewWindow(
-				execute.Duration(5*time.Minute),
-				execute.Duration(5*time.Minute),
-				0),
+				values.ConvertDuration(5*time.Minute),
+				values.ConvertDuration(5*time.Minute),
+				values.Duration{}),
 			t: execute.Time(6 * time.Minute),
 			want: execute.Bounds{
 				Start: execute.Time(5 * time.Minute),
@@ -70,9 +71,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "simple with offset",
 			w: execute.NewWindow(
-				execute.Duration(5*time.Minute),
-				execute.Duration(5*time.Minute),
-				execute.Duration(30*time.Second)),
+				values.ConvertDuration(5*time.Minute),
+				values.ConvertDuration(5*time.Minute),
+				values.ConvertDuration(30*time.Second)),
 			t: execute.Time(5 * time.Minute),
 			want: execute.Bounds{
 				Start: execute.Time(30 * time.Second),
@@ -82,9 +83,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "underlapping",
 			w: execute.NewWindow(
-				execute.Duration(2*time.Minute),
-				execute.Duration(1*time.Minute),
-				execute.Duration(30*time.Second)),
+				values.ConvertDuration(2*time.Minute),
+				values.ConvertDuration(1*time.Minute),
+				values.ConvertDuration(30*time.Second)),
 			t: execute.Time(3 * time.Minute),
 			want: execute.Bounds{
 				Start: execute.Time(3*time.Minute + 30*time.Second),
@@ -94,9 +95,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "underlapping not contained",
 			w: execute.NewWindow(
-				execute.Duration(2*time.Minute),
-				execute.Duration(1*time.Minute),
-				execute.Duration(30*time.Second)),
+				values.ConvertDuration(2*time.Minute),
+				values.ConvertDuration(1*time.Minute),
+				values.ConvertDuration(30*time.Second)),
 			t: execute.Time(2*time.Minute + 45*time.Second),
 			want: execute.Bounds{
 				Start: execute.Time(3*time.Minute + 30*time.Second),
@@ -106,9 +107,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "overlapping",
 			w: execute.NewWindow(
-				execute.Duration(1*time.Minute),
-				execute.Duration(2*time.Minute),
-				execute.Duration(30*time.Second)),
+				values.ConvertDuration(1*time.Minute),
+				values.ConvertDuration(2*time.Minute),
+				values.ConvertDuration(30*time.Second)),
 			t: execute.Time(30 * time.Second),
 			want: execute.Bounds{
 				Start: execute.Time(-30 * time.Second),
@@ -118,9 +119,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "partially overlapping",
 			w: execute.NewWindow(
-				execute.Duration(1*time.Minute),
-				execute.Duration(3*time.Minute+30*time.Second),
-				execute.Duration(30*time.Second)),
+				values.ConvertDuration(1*time.Minute),
+				values.ConvertDuration(3*time.Minute+30*time.Second),
+				values.ConvertDuration(30*time.Second)),
 			t: execute.Time(5*time.Minute + 45*time.Second),
 			want: execute.Bounds{
 				Start: execute.Time(3 * time.Minute),
@@ -130,9 +131,9 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 		{
 			name: "partially overlapping (t on boundary)",
 			w: execute.NewWindow(
-				execute.Duration(1*time.Minute),
-				execute.Duration(3*time.Minute+30*time.Second),
-				execute.Duration(30*time.Second)),
+				values.ConvertDuration(1*time.Minute),
+				values.ConvertDuration(3*time.Minute+30*time.Second),
+				values.ConvertDuration(30*time.Second)),
 			t: execute.Time(5 * time.Minute),
 			want: execute.Bounds{
 				Start: execute.Time(2 * time.Minute),
@@ -162,8 +163,8 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		{
 			name: "simple",
 			w: execute.Window{
-				Every:  execute.Duration(time.Minute),
-				Period: execute.Duration(time.Minute),
+				Every:  values.ConvertDuration(time.Minute),
+				Period: values.ConvertDuration(time.Minute),
 			},
 			b: execute.Bounds{
 				Start: execute.Time(5 * time.Minute),
@@ -178,9 +179,9 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		{
 			name: "simple with offset",
 			w: execute.Window{
-				Every:  execute.Duration(time.Minute),
-				Period: execute.Duration(time.Minute),
-				Offset: execute.Duration(15 * time.Second),
+				Every:  values.ConvertDuration(time.Minute),
+				Period: values.ConvertDuration(time.Minute),
+				Offset: values.ConvertDuration(15 * time.Second),
 			},
 			b: execute.Bounds{
 				Start: execute.Time(5 * time.Minute),
@@ -204,8 +205,8 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		{
 			name: "underlapping, bounds in gap",
 			w: execute.Window{
-				Every:  execute.Duration(2 * time.Minute),
-				Period: execute.Duration(time.Minute),
+				Every:  values.ConvertDuration(2 * time.Minute),
+				Period: values.ConvertDuration(time.Minute),
 			},
 			b: execute.Bounds{
 				Start: execute.Time(30 * time.Second),
@@ -216,9 +217,9 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		{
 			name: "underlapping",
 			w: execute.Window{
-				Every:  execute.Duration(2 * time.Minute),
-				Period: execute.Duration(time.Minute),
-				Offset: execute.Duration(30 * time.Second),
+				Every:  values.ConvertDuration(2 * time.Minute),
+				Period: values.ConvertDuration(time.Minute),
+				Offset: values.ConvertDuration(30 * time.Second),
 			},
 			b: execute.Bounds{
 				Start: execute.Time(time.Minute + 45*time.Second),
@@ -238,8 +239,8 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		{
 			name: "overlapping",
 			w: execute.Window{
-				Every:  execute.Duration(1 * time.Minute),
-				Period: execute.Duration(2*time.Minute + 15*time.Second),
+				Every:  values.ConvertDuration(1 * time.Minute),
+				Period: values.ConvertDuration(2*time.Minute + 15*time.Second),
 			},
 			b: execute.Bounds{
 				Start: execute.Time(10 * time.Minute),
@@ -264,6 +265,102 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "calendar months",
+			w: execute.Window{
+				Every:  values.MakeDuration(0, 1),
+				Period: values.MakeDuration(0, 1),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-01-15T00:00:00Z"),
+				Stop:  mustParseTime("2019-03-15T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: mustParseTime("2019-01-01T00:00:00Z"), Stop: mustParseTime("2019-02-01T00:00:00Z")},
+				{Start: mustParseTime("2019-02-01T00:00:00Z"), Stop: mustParseTime("2019-03-01T00:00:00Z")},
+				{Start: mustParseTime("2019-03-01T00:00:00Z"), Stop: mustParseTime("2019-04-01T00:00:00Z")},
+			},
+		},
+		{
+			name: "calendar months with offset",
+			w: execute.Window{
+				Every:  values.MakeDuration(0, 1),
+				Period: values.MakeDuration(0, 1),
+				Offset: values.ConvertDuration(24 * time.Hour),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-01-01T00:00:00Z"),
+				Stop:  mustParseTime("2019-02-15T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: mustParseTime("2018-12-02T00:00:00Z"), Stop: mustParseTime("2019-01-02T00:00:00Z")},
+				{Start: mustParseTime("2019-01-02T00:00:00Z"), Stop: mustParseTime("2019-02-02T00:00:00Z")},
+				{Start: mustParseTime("2019-02-02T00:00:00Z"), Stop: mustParseTime("2019-03-02T00:00:00Z")},
+			},
+		},
+		{
+			name: "calendar quarters",
+			w: execute.Window{
+				Every:  values.MakeDuration(0, 3),
+				Period: values.MakeDuration(0, 3),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-02-01T00:00:00Z"),
+				Stop:  mustParseTime("2019-07-01T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: mustParseTime("2019-01-01T00:00:00Z"), Stop: mustParseTime("2019-04-01T00:00:00Z")},
+				{Start: mustParseTime("2019-04-01T00:00:00Z"), Stop: mustParseTime("2019-07-01T00:00:00Z")},
+			},
+		},
+		{
+			name: "calendar years",
+			w: execute.Window{
+				Every:  values.MakeDuration(0, 12),
+				Period: values.MakeDuration(0, 12),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-06-01T00:00:00Z"),
+				Stop:  mustParseTime("2020-06-01T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: mustParseTime("2019-01-01T00:00:00Z"), Stop: mustParseTime("2020-01-01T00:00:00Z")},
+				{Start: mustParseTime("2020-01-01T00:00:00Z"), Stop: mustParseTime("2021-01-01T00:00:00Z")},
+			},
+		},
+		{
+			name: "days across daylight saving time",
+			w: execute.Window{
+				Every:    values.ConvertDuration(24 * time.Hour),
+				Period:   values.ConvertDuration(24 * time.Hour),
+				Location: mustLoadLocation("America/Denver"),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-03-09T12:00:00-07:00"),
+				Stop:  mustParseTime("2019-03-11T12:00:00-06:00"),
+			},
+			want: []execute.Bounds{
+				{Start: mustParseTime("2019-03-09T00:00:00-07:00"), Stop: mustParseTime("2019-03-10T00:00:00-07:00")},
+				{Start: mustParseTime("2019-03-10T00:00:00-07:00"), Stop: mustParseTime("2019-03-11T00:00:00-06:00")},
+				{Start: mustParseTime("2019-03-11T00:00:00-06:00"), Stop: mustParseTime("2019-03-12T00:00:00-06:00")},
+			},
+		},
+		{
+			name: "hours in fixed zone",
+			w: execute.Window{
+				Every:    values.ConvertDuration(time.Hour),
+				Period:   values.ConvertDuration(time.Hour),
+				Location: time.FixedZone("", 5*60*60+30*60),
+			},
+			b: execute.Bounds{
+				Start: mustParseTime("2019-03-10T12:15:00+05:30"),
+				Stop:  mustParseTime("2019-03-10T13:15:00+05:30"),
+			},
+			want: []execute.Bounds{
+				{Start: mustParseTime("2019-03-10T12:00:00+05:30"), Stop: mustParseTime("2019-03-10T13:00:00+05:30")},
+				{Start: mustParseTime("2019-03-10T13:00:00+05:30"), Stop: mustParseTime("2019-03-10T14:00:00+05:30")},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -276,3 +373,19 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		})
 	}
 }
+
+func mustParseTime(s string) execute.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return values.ConvertTime(t)
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
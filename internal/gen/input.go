@@ -130,7 +130,7 @@ func Input(schema Schema) (flux.ResultIterator, error) {
 		numPoints = DefaultNumPoints
 	}
 	g := &dataGenerator{
-		Period:    values.Duration(period),
+		Period:    values.ConvertDuration(period),
 		NumPoints: numPoints,
 		Nulls:     schema.Nulls,
 	}
@@ -441,10 +441,10 @@ func (dg *dataGenerator) Generate(tb execute.TableBuilder, r *rand.Rand, typ flu
 
 	start, stop = dg.Start, dg.Start
 	for i := 0; i < dg.NumPoints; i++ {
-		ts := dg.Start.Add(values.Duration(i) * dg.Period)
-		if dg.Jitter != 0 {
-			jitter := r.Intn(int(dg.Jitter)*2 + 1)
-			ts = ts.Add(values.Duration(jitter))
+		ts := dg.Start.Add(dg.Period.Mul(int64(i)))
+		if !dg.Jitter.IsZero() {
+			jitter := r.Intn(int(dg.Jitter.Nanoseconds())*2 + 1)
+			ts = ts.Add(values.ConvertDuration(time.Duration(jitter)))
 		}
 		_ = tb.AppendTime(timeIdx, ts)
 		_ = tb.AppendValue(valueIdx, next())
@@ -15,7 +15,10 @@ import (
 	"github.com/opentracing/opentracing-go"
 )
 
-const nowOption = "now"
+const (
+	nowOption      = "now"
+	locationOption = "location"
+)
 
 // FromScript returns a spec from a script expressed as a raw string.
 func FromScript(ctx context.Context, script string, now time.Time) (*flux.Spec, error) {
@@ -57,6 +60,14 @@ func FromAST(ctx context.Context, astPkg *ast.Package, now time.Time) (*flux.Spe
 		return nil, err
 	}
 
+	if locationOpt, ok := scope.Lookup(locationOption); ok {
+		location, err := flux.LocationFromValue(locationOpt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %q option: %v", locationOption, err)
+		}
+		spec.Location = location
+	}
+
 	if len(spec.Operations) == 0 {
 		return nil,
 			errors.New("this Flux script returns no streaming data. " +
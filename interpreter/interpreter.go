@@ -3,6 +3,7 @@ package interpreter
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/semantic"
@@ -285,7 +286,7 @@ func (itrp *Interpreter) doExpression(expr semantic.Expression, scope Scope) (va
 			case semantic.Float:
 				return values.NewFloat(-v.Float()), nil
 			case semantic.Duration:
-				return values.NewDuration(-v.Duration()), nil
+				return values.NewDuration(v.Duration().Neg()), nil
 			default:
 				return nil, fmt.Errorf("operand to unary expression is not a number value, got %v", v.Type())
 			}
@@ -421,7 +422,7 @@ func (itrp *Interpreter) doLiteral(lit semantic.Literal) (values.Value, error) {
 	case *semantic.DateTimeLiteral:
 		return values.NewTime(values.Time(l.Value.UnixNano())), nil
 	case *semantic.DurationLiteral:
-		return values.NewDuration(values.Duration(l.Value)), nil
+		return values.NewDuration(values.MakeDuration(int64(l.Value), l.Months)), nil
 	case *semantic.FloatLiteral:
 		return values.NewFloat(l.Value), nil
 	case *semantic.IntegerLiteral:
@@ -481,6 +482,16 @@ func (itrp *Interpreter) doCall(call *semantic.CallExpression, scope Scope) (val
 	if err != nil {
 		return nil, err
 	}
+	if od, ok := f.(OptionDefaulter); ok {
+		for param, option := range od.OptionDefaults() {
+			if _, ok := argObj.Get(param); ok {
+				continue
+			}
+			if v, ok := scope.Lookup(option); ok {
+				argObj.Set(param, v)
+			}
+		}
+	}
 
 	// Check if the function is an interpFunction and rebind it.
 	if af, ok := f.(function); ok {
@@ -702,6 +713,45 @@ func (f function) String() string {
 	return fmt.Sprintf("%v", f.PolyType())
 }
 
+// OptionDefaulter is implemented by functions whose parameters default to the
+// value of an option in the scope of the caller.
+type OptionDefaulter interface {
+	// OptionDefaults maps parameter names to the names of the options that provide their defaults.
+	OptionDefaults() map[string]string
+}
+
+// WithOptionDefaults returns a function that defaults the given parameters to the
+// options in the scope of its caller.
+func WithOptionDefaults(f values.Function, defaults map[string]string) values.Function {
+	return optionDefaultsFunction{
+		Value:    f,
+		fn:       f,
+		defaults: defaults,
+	}
+}
+
+type optionDefaultsFunction struct {
+	values.Value
+	fn       values.Function
+	defaults map[string]string
+}
+
+func (f optionDefaultsFunction) Function() values.Function {
+	return f
+}
+
+func (f optionDefaultsFunction) Call(args values.Object) (values.Value, error) {
+	return f.fn.Call(args)
+}
+
+func (f optionDefaultsFunction) HasSideEffect() bool {
+	return f.fn.HasSideEffect()
+}
+
+func (f optionDefaultsFunction) OptionDefaults() map[string]string {
+	return f.defaults
+}
+
 // Resolver represents a value that can resolve itself
 type Resolver interface {
 	Resolve() (semantic.Node, error)
@@ -793,6 +843,9 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Arguments = node.(*semantic.ObjectExpression)
+		if err := f.resolveOptionDefaults(n); err != nil {
+			return nil, err
+		}
 	case *semantic.FunctionExpression:
 		node, err := f.resolveIdentifiers(n.Block.Body)
 		if err != nil {
@@ -883,6 +936,72 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 	return n, nil
 }
 
+// resolveOptionDefaults adds the options of the function scope as arguments
+// to calls of an OptionDefaulter that do not set them.
+func (f function) resolveOptionDefaults(call *semantic.CallExpression) error {
+	callee, ok := f.lookupCallee(call.Callee)
+	if !ok || callee.Type().Nature() != semantic.Function {
+		return nil
+	}
+	od, ok := callee.Function().(OptionDefaulter)
+	if !ok {
+		return nil
+	}
+	if call.Arguments == nil {
+		call.Arguments = new(semantic.ObjectExpression)
+	}
+	for param, option := range od.OptionDefaults() {
+		if hasProperty(call.Arguments, param) {
+			continue
+		}
+		v, ok := f.scope.Lookup(option)
+		if !ok {
+			continue
+		}
+		node, err := resolveValue(v)
+		if err != nil {
+			return err
+		}
+		call.Arguments.Properties = append(call.Arguments.Properties, &semantic.Property{
+			Key:   &semantic.Identifier{Name: param},
+			Value: node.(semantic.Expression),
+		})
+	}
+	return nil
+}
+
+// lookupCallee finds the value of a callee that is an identifier or a member of an
+// identifier in the function scope.
+func (f function) lookupCallee(callee semantic.Expression) (values.Value, bool) {
+	switch c := callee.(type) {
+	case *semantic.IdentifierExpression:
+		if f.e.Block.Parameters != nil {
+			for _, p := range f.e.Block.Parameters.List {
+				if c.Name == p.Key.Name {
+					return nil, false
+				}
+			}
+		}
+		return f.scope.Lookup(c.Name)
+	case *semantic.MemberExpression:
+		obj, ok := f.lookupCallee(c.Object)
+		if !ok || obj.Type().Nature() != semantic.Object {
+			return nil, false
+		}
+		return obj.Object().Get(c.Property)
+	}
+	return nil, false
+}
+
+func hasProperty(obj *semantic.ObjectExpression, key string) bool {
+	for _, p := range obj.Properties {
+		if p.Key.Key() == key {
+			return true
+		}
+	}
+	return false
+}
+
 func resolveValue(v values.Value) (semantic.Node, error) {
 	switch k := v.Type().Nature(); k {
 	case semantic.String:
@@ -915,7 +1034,8 @@ func resolveValue(v values.Value) (semantic.Node, error) {
 		}, nil
 	case semantic.Duration:
 		return &semantic.DurationLiteral{
-			Value: v.Duration().Duration(),
+			Months: v.Duration().Months(),
+			Value:  time.Duration(v.Duration().Nanoseconds()),
 		}, nil
 	case semantic.Function:
 		resolver, ok := v.Function().(Resolver)
@@ -274,6 +274,20 @@ func (p *Program) processResults(ctx context.Context, q *query, resultMap map[st
 func (p *Program) readMetadata(q *query, metaCh <-chan flux.Metadata) {
 	defer q.wg.Done()
 	for md := range metaCh {
+		if nodes, ok := md[execute.NodeStatisticsMetadataKey]; ok {
+			for _, n := range nodes {
+				q.stats.Nodes = append(q.stats.Nodes, n.(flux.NodeStatistics))
+			}
+			md.Del(execute.NodeStatisticsMetadataKey)
+		}
+		if errs, ok := md[execute.ErrorsMetadataKey]; ok {
+			for _, err := range errs {
+				err := err.(error)
+				q.errs = append(q.errs, err)
+				q.stats.RuntimeErrors = append(q.stats.RuntimeErrors, err.Error())
+			}
+			md.Del(execute.ErrorsMetadataKey)
+		}
 		q.stats.Metadata.AddAll(md)
 	}
 }
@@ -295,6 +309,7 @@ func (p *AstProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Q
 	if p.Now.IsZero() {
 		p.Now = time.Now()
 	}
+	start := time.Now()
 	s, err := spec.FromAST(ctx, p.Ast, p.Now)
 	if err != nil {
 		return nil, errors.Wrap(err, "error in evaluating AST while starting program")
@@ -307,6 +322,12 @@ func (p *AstProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Q
 		return nil, errors.Wrap(err, "error in building plan while starting program")
 	}
 	p.PlanSpec = ps
+	planDuration := time.Since(start)
 
-	return p.Program.Start(ctx, alloc)
+	q, err := p.Program.Start(ctx, alloc)
+	if err != nil {
+		return nil, err
+	}
+	q.(*query).stats.PlanDuration = planDuration
+	return q, nil
 }
@@ -99,6 +99,7 @@ csv.from(csv: "foo,bar") |> range(start: 2017-10-10T00:00:00Z)
 					{1, 2},
 				},
 				Resources: flux.ResourceManagement{ConcurrencyQuota: 1, MemoryBytesQuota: math.MaxInt64},
+				MessageQueueSize: plan.DefaultMessageQueueSize,
 				Now:       parser.MustParseTime("2017-10-10T00:01:00Z").Value,
 			},
 		},
@@ -120,6 +121,7 @@ csv.from(csv: "foo,bar") |> range(start: 2017-10-10T00:00:00Z)
 					{1, 2},
 				},
 				Resources: flux.ResourceManagement{ConcurrencyQuota: 1, MemoryBytesQuota: math.MaxInt64},
+				MessageQueueSize: plan.DefaultMessageQueueSize,
 				Now:       parser.MustParseTime("2018-10-10T00:00:00Z").Value,
 			},
 		},
@@ -154,6 +156,7 @@ csv.from(csv: "foo,bar") |> range(start: 2017-10-10T00:00:00Z)
 					{1, 2},
 				},
 				Resources: flux.ResourceManagement{ConcurrencyQuota: 1, MemoryBytesQuota: math.MaxInt64},
+				MessageQueueSize: plan.DefaultMessageQueueSize,
 				Now:       parser.MustParseTime("2018-10-10T00:00:00Z").Value,
 			},
 		},
@@ -223,6 +226,7 @@ func TestCompileOptions(t *testing.T) {
 			{1, 2},
 		},
 		Resources: flux.ResourceManagement{ConcurrencyQuota: 1, MemoryBytesQuota: math.MaxInt64},
+		MessageQueueSize: plan.DefaultMessageQueueSize,
 		Now:       parser.MustParseTime("2018-10-10T00:00:00Z").Value,
 	})
 
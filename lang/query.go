@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/memory"
 )
 
@@ -14,6 +15,7 @@ type query struct {
 	alloc   *memory.Allocator
 	cancel  func()
 	err     error
+	errs    execute.MultiError
 	wg      sync.WaitGroup
 }
 
@@ -24,12 +26,18 @@ func (q *query) Results() <-chan flux.Result {
 func (q *query) Done() {
 	q.cancel()
 	q.wg.Wait()
+	if q.err == nil {
+		// The errors of the nodes are only known once they have all finished.
+		q.err = q.errs.ErrorOrNil()
+	}
 }
 
 func (q *query) Cancel() {
 	q.cancel()
 }
 
+// Err reports the error of the query. Once Done has returned,
+// it also reports the errors produced by the nodes of the plan.
 func (q *query) Err() error {
 	return q.err
 }
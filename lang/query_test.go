@@ -8,8 +8,10 @@ import (
 	_ "github.com/influxdata/flux/builtin"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/lang"
 	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
 	"github.com/pkg/errors"
 )
 
@@ -165,4 +167,66 @@ csv.from(csv: data) |> map(fn: (r) => r.nonexistent)`
 	if q.Err() != nil {
 		t.Fatal(errors.Wrap(q.Err(), "unexpected error from query execution"))
 	}
+
+	// the error of the map node is reported once the query is done
+	q.Done()
+	if q.Err() == nil {
+		t.Fatal("expected error from query execution, got none")
+	}
+	if got := q.Statistics().RuntimeErrors; len(got) != 1 {
+		t.Errorf("unexpected runtime errors: %v", got)
+	}
+}
+
+func TestQuery_RuntimeErrors(t *testing.T) {
+	var invalidScript = `
+import "csv"
+
+data = "
+#datatype,string,long,long,string
+#group,false,false,false,true
+#default,_result,,,
+,result,table,value,tag
+,,0,10,a
+"
+
+csv.from(csv: data) |> map(fn: (r) => r.nonexistent) |> yield(name: "a")
+csv.from(csv: data) |> map(fn: (r) => r.missing) |> yield(name: "b")`
+
+	q, err := runQuery(invalidScript)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "unexpected error while creating query"))
+	}
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			return tbl.Do(func(cr flux.ColReader) error {
+				return nil
+			})
+		}); err == nil {
+			t.Errorf("expected error from result %s, got none", res.Name())
+		}
+	}
+	q.Done()
+
+	multiErr, ok := q.Err().(execute.MultiError)
+	if !ok {
+		t.Fatalf("expected an error for each map node, got %v", q.Err())
+	}
+	if len(multiErr) != 2 {
+		t.Fatalf("unexpected number of errors: %v", multiErr)
+	}
+	nodes := make(map[plan.NodeID]bool)
+	for _, err := range multiErr {
+		ne, ok := err.(*execute.NodeError)
+		if !ok {
+			t.Fatalf("unexpected error type %T: %v", err, err)
+		}
+		nodes[ne.NodeID] = true
+	}
+	if len(nodes) != 2 {
+		t.Errorf("expected errors from two distinct nodes, got %v", multiErr)
+	}
+	if got := q.Statistics().RuntimeErrors; len(got) != 2 {
+		t.Errorf("unexpected runtime errors: %v", got)
+	}
 }
@@ -3,6 +3,7 @@ package memory
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sync/atomic"
 )
 
@@ -10,12 +11,33 @@ import (
 type Allocator struct {
 	// Limit is the limit on the amount of memory that this allocator
 	// can assign. If this is null, there is no limit.
+	// The limit is raised by the Manager, so it must only be
+	// read with atomic operations while the allocator is in use.
 	Limit *int64
 
+	// Manager is asked for more memory when an allocation would
+	// exceed the Limit. If this is null, the Limit is fixed.
+	Manager Manager
+
+	// Parent is charged for the memory allocated by this allocator as well,
+	// so the limit of the parent also applies to this allocator.
+	// It is used to track the memory of a part of a query separately.
+	// If this is null, there is no parent.
+	Parent *Allocator
+
 	bytesAllocated int64
 	maxAllocated   int64
 }
 
+// Manager grants additional memory to an Allocator that has reached its limit.
+type Manager interface {
+	// RequestMemory requests at least want additional bytes. It raises the Limit of the
+	// Allocator by the bytes it grants, so that the limit never includes bytes that
+	// the manager has already taken back, and returns the number of bytes granted.
+	// An error is returned if the bytes cannot be granted.
+	RequestMemory(want int64) (got int64, err error)
+}
+
 // Allocate will ensure that the requested memory is available and
 // record that it is in use.
 func (a *Allocator) Allocate(size int) error {
@@ -24,6 +46,16 @@ func (a *Allocator) Allocate(size int) error {
 	} else if size == 0 {
 		return nil
 	}
+	if a.Parent != nil {
+		if err := a.Parent.Allocate(size); err != nil {
+			return err
+		}
+		if err := a.count(size); err != nil {
+			a.Parent.Free(size)
+			return err
+		}
+		return nil
+	}
 	return a.count(size)
 }
 
@@ -32,6 +64,27 @@ func (a *Allocator) Allocated() int64 {
 	return atomic.LoadInt64(&a.bytesAllocated)
 }
 
+// Available returns the number of bytes that can still be allocated
+// before the limit of this allocator or its parent is reached.
+// If there is no limit, it returns math.MaxInt64.
+func (a *Allocator) Available() int64 {
+	available := int64(math.MaxInt64)
+	if a.Limit != nil {
+		available = atomic.LoadInt64(a.Limit) - a.Allocated()
+	}
+	if a.Parent != nil {
+		if n := a.Parent.Available(); n < available {
+			available = n
+		}
+	}
+	return available
+}
+
+// Limited reports whether the allocator or one of its parents has a limit.
+func (a *Allocator) Limited() bool {
+	return a.Limit != nil || (a.Parent != nil && a.Parent.Limited())
+}
+
 // MaxAllocated reports the maximum amount of allocated memory at any point in the query.
 func (a *Allocator) MaxAllocated() int64 {
 	return atomic.LoadInt64(&a.maxAllocated)
@@ -47,6 +100,9 @@ func (a *Allocator) Free(size int) {
 		panic(errors.New("cannot free negative memory"))
 	}
 	atomic.AddInt64(&a.bytesAllocated, int64(-size))
+	if a.Parent != nil {
+		a.Parent.Free(size)
+	}
 }
 
 func (a *Allocator) count(size int) error {
@@ -57,12 +113,16 @@ func (a *Allocator) count(size int) error {
 		// to modify the bytes allocated.
 		for {
 			allocated := atomic.LoadInt64(&a.bytesAllocated)
-			if want := allocated + int64(size); want > *a.Limit {
-				return LimitExceededError{
-					Limit:     *a.Limit,
-					Allocated: allocated,
-					Wanted:    want - allocated,
+			if want, limit := allocated+int64(size), atomic.LoadInt64(a.Limit); want > limit {
+				if err := a.requestMemory(want - limit); err != nil {
+					return LimitExceededError{
+						Limit:     limit,
+						Allocated: allocated,
+						Wanted:    want - allocated,
+					}
 				}
+				// The limit was raised so try again.
+				continue
 			} else if atomic.CompareAndSwapInt64(&a.bytesAllocated, allocated, want) {
 				c = want
 				break
@@ -84,6 +144,22 @@ func (a *Allocator) count(size int) error {
 	return nil
 }
 
+// requestMemory asks the manager for at least want bytes.
+// The manager raises the limit by the bytes it grants.
+func (a *Allocator) requestMemory(want int64) error {
+	if a.Manager == nil {
+		return errors.New("no memory manager")
+	}
+	got, err := a.Manager.RequestMemory(want)
+	if err != nil {
+		return err
+	}
+	if got < want {
+		return fmt.Errorf("memory manager granted %d bytes, wanted %d", got, want)
+	}
+	return nil
+}
+
 // LimitExceededError is an error when the allocation limit is exceeded.
 type LimitExceededError struct {
 	Limit     int64
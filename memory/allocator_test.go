@@ -1,6 +1,7 @@
 package memory_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/influxdata/flux/memory"
@@ -127,3 +128,92 @@ func TestAllocator_Free(t *testing.T) {
 		t.Fatalf("unexpected max allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
 	}
 }
+
+// testManager grants memory until its budget is exhausted.
+type testManager struct {
+	limit  *int64
+	budget int64
+}
+
+func (m *testManager) RequestMemory(want int64) (int64, error) {
+	if want > m.budget {
+		return 0, errors.New("memory budget exhausted")
+	}
+	m.budget -= want
+	*m.limit += want
+	return want, nil
+}
+
+func TestAllocator_Manager(t *testing.T) {
+	limit := int64(64)
+	manager := &testManager{limit: &limit, budget: 32}
+	allocator := &memory.Allocator{Limit: &limit, Manager: manager}
+	if err := allocator.Allocate(64); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Allocating beyond the limit requests the memory from the manager.
+	if err := allocator.Allocate(16); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want, got := int64(80), limit; want != got {
+		t.Fatalf("unexpected limit -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := int64(0), allocator.Available(); want != got {
+		t.Fatalf("unexpected available count -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// The manager does not have enough memory left.
+	err := allocator.Allocate(32)
+	if _, ok := err.(memory.LimitExceededError); !ok {
+		t.Fatalf("expected limit exceeded error, got %v", err)
+	}
+	if want, got := int64(80), allocator.Allocated(); want != got {
+		t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := int64(80), limit; want != got {
+		t.Fatalf("unexpected limit -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+}
+
+func TestAllocator_Parent(t *testing.T) {
+	limit := int64(64)
+	parent := &memory.Allocator{Limit: &limit}
+	a := &memory.Allocator{Parent: parent}
+	b := &memory.Allocator{Parent: parent}
+
+	if err := a.Allocate(32); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := b.Allocate(16); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want, got := int64(48), parent.Allocated(); want != got {
+		t.Fatalf("unexpected allocated count of the parent -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	if !a.Limited() {
+		t.Fatal("expected the limit of the parent to apply")
+	}
+	if want, got := int64(16), a.Available(); want != got {
+		t.Fatalf("unexpected available count -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// The limit of the parent applies to the child.
+	if err := a.Allocate(32); err == nil {
+		t.Fatal("expected limit exceeded error")
+	}
+	if want, got := int64(32), a.Allocated(); want != got {
+		t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	a.Free(32)
+	if want, got := int64(16), parent.Allocated(); want != got {
+		t.Fatalf("unexpected allocated count of the parent -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := int64(32), a.MaxAllocated(); want != got {
+		t.Fatalf("unexpected max allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := int64(48), parent.MaxAllocated(); want != got {
+		t.Fatalf("unexpected max allocated count of the parent -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+}
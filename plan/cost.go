@@ -1,10 +1,31 @@
 package plan
 
+import "math/bits"
+
+// Statistics are the estimated statistics of the tables produced by a plan node.
 type Statistics struct {
-	Cardinality      int64
+	// Cardinality is the estimated number of rows.
+	Cardinality int64
+	// GroupCardinality is the estimated number of tables.
 	GroupCardinality int64
 }
 
+// DefaultSourceStatistics are the statistics of a source that cannot estimate its output.
+var DefaultSourceStatistics = Statistics{
+	Cardinality:      1000,
+	GroupCardinality: 1,
+}
+
+// SumStatistics adds the statistics of the inputs of a node together.
+func SumStatistics(stats []Statistics) Statistics {
+	var sum Statistics
+	for _, s := range stats {
+		sum.Cardinality += s.Cardinality
+		sum.GroupCardinality += s.GroupCardinality
+	}
+	return sum
+}
+
 // Cost stores various dimensions of the cost of a query plan
 type Cost struct {
 	Disk int64
@@ -25,9 +46,115 @@ func Add(a Cost, b Cost) Cost {
 	}
 }
 
+// Total is the sum of all the dimensions of the cost.
+func (c Cost) Total() int64 {
+	return c.Disk + c.CPU + c.GPU + c.MEM + c.NET
+}
+
+// Less reports whether the cost is lower than the other cost.
+func (c Cost) Less(o Cost) bool {
+	return c.Total() < o.Total()
+}
+
+// SortCost is the cost of sorting the rows of the input.
+func SortCost(in Statistics) Cost {
+	n := in.Cardinality
+	return Cost{
+		CPU: n * int64(bits.Len64(uint64(n))),
+		MEM: n,
+	}
+}
+
+// DefaultCost is the cost of a procedure that reads each row of its inputs once
+// and produces as many rows and tables as it reads.
+// A source with this cost produces the DefaultSourceStatistics.
 type DefaultCost struct {
 }
 
 func (c DefaultCost) Cost(inStats []Statistics) (Cost, Statistics) {
-	return Cost{}, Statistics{}
+	if len(inStats) == 0 {
+		return Cost{}, DefaultSourceStatistics
+	}
+	out := SumStatistics(inStats)
+	return Cost{CPU: out.Cardinality}, out
+}
+
+// coster is implemented by the procedure specs that estimate their cost.
+// Every PhysicalProcedureSpec is a coster.
+type coster interface {
+	Cost(inStats []Statistics) (Cost, Statistics)
+}
+
+// nodeCost returns the self-cost of the node, using DefaultCost
+// if the procedure spec of the node does not estimate its cost.
+func nodeCost(node Node, inStats []Statistics) (Cost, Statistics) {
+	if c, ok := node.ProcedureSpec().(coster); ok {
+		return c.Cost(inStats)
+	}
+	return DefaultCost{}.Cost(inStats)
+}
+
+// costEstimator estimates the cost of the nodes in a plan.
+// The cost of a node that is the predecessor of more than one node is only counted once.
+type costEstimator struct {
+	stats   map[Node]Statistics
+	counted map[Node]bool
+}
+
+func newCostEstimator() *costEstimator {
+	return &costEstimator{
+		stats:   make(map[Node]Statistics),
+		counted: make(map[Node]bool),
+	}
+}
+
+func (e *costEstimator) estimate(node Node) (Cost, Statistics) {
+	if e.counted[node] {
+		return Cost{}, e.stats[node]
+	}
+	var total Cost
+	inStats := make([]Statistics, len(node.Predecessors()))
+	for i, pred := range node.Predecessors() {
+		cost, stats := e.estimate(pred)
+		total = Add(total, cost)
+		inStats[i] = stats
+	}
+	cost, stats := nodeCost(node, inStats)
+	e.counted[node] = true
+	e.stats[node] = stats
+	return Add(total, cost), stats
+}
+
+// EstimateCost estimates the cost of the sub-plan rooted at the node
+// and the statistics of the tables the node produces.
+func EstimateCost(node Node) (Cost, Statistics) {
+	return newCostEstimator().estimate(node)
+}
+
+// ChainCost estimates the cost of applying the procedures in order,
+// where the first procedure reads the inputs and each of the others reads
+// the output of the procedure before it. It is used to compare equivalent orderings
+// of procedures without changing the plan.
+func ChainCost(inStats []Statistics, specs ...PhysicalProcedureSpec) (Cost, Statistics) {
+	var total Cost
+	stats := inStats
+	var out Statistics
+	for _, spec := range specs {
+		var cost Cost
+		cost, out = spec.Cost(stats)
+		total = Add(total, cost)
+		stats = []Statistics{out}
+	}
+	return total, out
+}
+
+// Cost estimates the total cost of the plan.
+func (plan *Spec) Cost() Cost {
+	e := newCostEstimator()
+	var total Cost
+	for root := range plan.Roots {
+		cost, _ := e.estimate(root)
+		total = Add(total, cost)
+	}
+	return total
 }
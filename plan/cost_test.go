@@ -0,0 +1,65 @@
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+)
+
+func TestEstimateCost(t *testing.T) {
+	// The source is read by two nodes, but its cost is only counted once.
+	//
+	//     join
+	//     /  \
+	//    a    b
+	//     \  /
+	//     from
+	spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plantest.CreatePhysicalMockNode("from"),
+			plantest.CreatePhysicalMockNode("a"),
+			plantest.CreatePhysicalMockNode("b"),
+			plantest.CreatePhysicalMockNode("join"),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{0, 2},
+			{1, 3},
+			{2, 3},
+		},
+	})
+
+	var join plan.Node
+	for root := range spec.Roots {
+		join = root
+	}
+	cost, stats := plan.EstimateCost(join)
+
+	n := plan.DefaultSourceStatistics.Cardinality
+	if want := (plan.Cost{CPU: 4 * n}); !cmp.Equal(want, cost) {
+		t.Errorf("unexpected cost -want/+got\n%s", cmp.Diff(want, cost))
+	}
+	wantStats := plan.Statistics{
+		Cardinality:      2 * n,
+		GroupCardinality: 2 * plan.DefaultSourceStatistics.GroupCardinality,
+	}
+	if !cmp.Equal(wantStats, stats) {
+		t.Errorf("unexpected statistics -want/+got\n%s", cmp.Diff(wantStats, stats))
+	}
+	if got := spec.Cost(); !cmp.Equal(cost, got) {
+		t.Errorf("unexpected plan cost -want/+got\n%s", cmp.Diff(cost, got))
+	}
+}
+
+func TestChainCost(t *testing.T) {
+	in := []plan.Statistics{{Cardinality: 10, GroupCardinality: 2}}
+	cost, stats := plan.ChainCost(in, plantest.MockProcedureSpec{}, plantest.MockProcedureSpec{})
+	if want := (plan.Cost{CPU: 20}); !cmp.Equal(want, cost) {
+		t.Errorf("unexpected cost -want/+got\n%s", cmp.Diff(want, cost))
+	}
+	if !cmp.Equal(in[0], stats) {
+		t.Errorf("unexpected statistics -want/+got\n%s", cmp.Diff(in[0], stats))
+	}
+}
@@ -0,0 +1,252 @@
+package plan
+
+import (
+	"reflect"
+
+	"github.com/influxdata/flux/ast"
+)
+
+// eliminateCommonSubexpressions merges the nodes of the plan that have identical
+// procedure specs and the same predecessors into a single node with the successors
+// of all of them, so that the inputs shared by several results are only computed once.
+//
+//     yield   yield          yield   yield
+//       |       |              |       |
+//      sum     mean           sum     mean
+//       |       |               \     /
+//     filter  filter    ==>     filter
+//       |       |                 |
+//     range   range             range
+//       |       |                 |
+//     from    from              from
+//
+// Yields and procedures with side effects are never merged.
+// A source is only merged when its duplicates have the same successors, because
+// a source with several different successors cannot have any of them pushed down into it.
+func eliminateCommonSubexpressions(plan *Spec) error {
+	var nodes []Node
+	if err := plan.BottomUpWalk(func(node Node) error {
+		nodes = append(nodes, node)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Deciding not to merge a source can keep its successors
+	// from being merged, so repeat until the sources agree.
+	distinct := make(map[Node]bool)
+	for {
+		same := findCommonSubexpressions(nodes, distinct)
+		changed := false
+		for _, node := range nodes {
+			if len(node.Predecessors()) == 0 && same[node] != node && !sameSuccessors(node, same[node], same) {
+				distinct[node] = true
+				changed = true
+			}
+		}
+		if !changed {
+			for _, node := range nodes {
+				if same[node] != node {
+					mergeNodes(same[node], node)
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// findCommonSubexpressions maps each of the nodes, which are in bottom-up order,
+// to the first node that computes the same tables, which may be the node itself.
+func findCommonSubexpressions(nodes []Node, distinct map[Node]bool) map[Node]Node {
+	same := make(map[Node]Node, len(nodes))
+	kept := make(map[ProcedureKind][]Node)
+	for _, node := range nodes {
+		same[node] = node
+		spec := node.ProcedureSpec()
+		if _, ok := spec.(YieldProcedureSpec); ok || HasSideEffect(spec) || distinct[node] {
+			continue
+		}
+		for _, k := range kept[node.Kind()] {
+			if isCommonSubexpression(node, k, same) {
+				same[node] = k
+				break
+			}
+		}
+		if same[node] == node {
+			kept[node.Kind()] = append(kept[node.Kind()], node)
+		}
+	}
+	return same
+}
+
+// isCommonSubexpression reports whether the two nodes compute the same tables.
+func isCommonSubexpression(a, b Node, same map[Node]Node) bool {
+	if len(a.Predecessors()) != len(b.Predecessors()) {
+		return false
+	}
+	for i, pred := range a.Predecessors() {
+		if same[pred] != same[b.Predecessors()[i]] {
+			return false
+		}
+	}
+	for _, succ := range a.Successors() {
+		if isNodeInNodes(succ, b.Successors()) {
+			// Merging the nodes would make the successor
+			// read the same tables twice.
+			return false
+		}
+	}
+	return equalSpecs(a.ProcedureSpec(), b.ProcedureSpec())
+}
+
+// sameSuccessors reports whether each successor of one node
+// computes the same tables as a successor of the other.
+func sameSuccessors(a, b Node, same map[Node]Node) bool {
+	contains := func(nodes []Node, node Node) bool {
+		for _, n := range nodes {
+			if same[n] == same[node] {
+				return true
+			}
+		}
+		return false
+	}
+	for _, succ := range a.Successors() {
+		if !contains(b.Successors(), succ) {
+			return false
+		}
+	}
+	for _, succ := range b.Successors() {
+		if !contains(a.Successors(), succ) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeNodes moves the successors of the duplicate node to the node
+// and removes the duplicate from the plan.
+//
+//     A   B             A   B     <-- successors
+//     |   |              \ /
+//   node  dup   becomes  node
+//      \ /                |
+//       C                 C       <-- predecessors
+func mergeNodes(node, dup Node) {
+	for _, pred := range dup.Predecessors() {
+		succs := make([]Node, 0, len(pred.Successors()))
+		for _, succ := range pred.Successors() {
+			if succ != dup {
+				succs = append(succs, succ)
+			}
+		}
+		pred.ClearSuccessors()
+		pred.AddSuccessors(succs...)
+	}
+	for _, succ := range dup.Successors() {
+		for i, succPred := range succ.Predecessors() {
+			if succPred == dup {
+				succ.Predecessors()[i] = node
+			}
+		}
+	}
+	node.AddSuccessors(dup.Successors()...)
+	dup.ClearSuccessors()
+	dup.ClearPredecessors()
+}
+
+var sourceLocationType = reflect.TypeOf(ast.SourceLocation{})
+
+// equalSpecs reports whether two procedure specs are structurally identical.
+// The specs are compared like reflect.DeepEqual compares them, except that
+// the source locations of expressions are ignored, so that the same expression
+// written twice in a script is equal.
+func equalSpecs(a, b ProcedureSpec) bool {
+	return deepEqual(reflect.ValueOf(a), reflect.ValueOf(b), make(map[visit]bool))
+}
+
+// visit is a pair of references that are being compared.
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+func deepEqual(a, b reflect.Value, visited map[visit]bool) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if a.Pointer() == b.Pointer() && (a.Kind() != reflect.Slice || a.Len() == b.Len()) {
+			return true
+		}
+		// Comparing references that are already being compared
+		// does not find any new difference, which also stops cycles.
+		v := visit{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+		if visited[v] {
+			return true
+		}
+		visited[v] = true
+	}
+
+	switch a.Kind() {
+	case reflect.Array, reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !deepEqual(a.Index(i), b.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Interface, reflect.Ptr:
+		return deepEqual(a.Elem(), b.Elem(), visited)
+	case reflect.Struct:
+		for i, n := 0, a.NumField(); i < n; i++ {
+			if a.Type().Field(i).Type.ConvertibleTo(sourceLocationType) {
+				continue
+			}
+			if !deepEqual(a.Field(i), b.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, k := range a.MapKeys() {
+			v := b.MapIndex(k)
+			if !v.IsValid() || !deepEqual(a.MapIndex(k), v, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		// Functions are only equal if they are both nil.
+		return a.IsNil() && b.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	default:
+		return false
+	}
+}
@@ -1,9 +1,24 @@
 package plan
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+)
 
 type FormatOption func(*formatter)
 
+// WithNodeStatistics annotates each node of the formatted plan
+// with the runtime statistics collected when the plan was executed.
+func WithNodeStatistics(stats []flux.NodeStatistics) FormatOption {
+	return func(f *formatter) {
+		f.stats = make(map[NodeID]flux.NodeStatistics, len(stats))
+		for _, s := range stats {
+			f.stats[NodeID(s.ID)] = s
+		}
+	}
+}
+
 // TODO(cwolff): enhance the this output to make it more useful
 func Formatted(p *Spec, opts ...FormatOption) fmt.Formatter {
 	f := formatter{
@@ -16,14 +31,20 @@ func Formatted(p *Spec, opts ...FormatOption) fmt.Formatter {
 }
 
 type formatter struct {
-	p *Spec
+	p     *Spec
+	stats map[NodeID]flux.NodeStatistics
 }
 
 func (f formatter) Format(fs fmt.State, c rune) {
 	fmt.Fprintf(fs, "\ndigraph {\n")
 	var edges []string
 	f.p.BottomUpWalk(func(pn Node) error {
-		fmt.Fprintf(fs, "  %v\n", pn.ID())
+		if s, ok := f.stats[pn.ID()]; ok {
+			fmt.Fprintf(fs, "  %v [tables_in=%d rows_in=%d tables_out=%d rows_out=%d process=%q dispatch=%q max_allocated=%d]\n",
+				pn.ID(), s.TablesIn, s.RowsIn, s.TablesOut, s.RowsOut, s.ProcessDuration, s.DispatchDuration, s.MaxAllocated)
+		} else {
+			fmt.Fprintf(fs, "  %v\n", pn.ID())
+		}
 		for _, pred := range pn.Predecessors() {
 			edges = append(edges, fmt.Sprintf("  %v -> %v", pred.ID(), pn.ID()))
 		}
@@ -0,0 +1,44 @@
+package plan_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+)
+
+func TestFormatted_NodeStatistics(t *testing.T) {
+	spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plantest.CreatePhysicalMockNode("0"),
+			plantest.CreatePhysicalMockNode("1"),
+		},
+		Edges: [][2]int{
+			{0, 1},
+		},
+	})
+	stats := []flux.NodeStatistics{{
+		ID:               "0",
+		TablesOut:        2,
+		RowsOut:          10,
+		ProcessDuration:  time.Millisecond,
+		DispatchDuration: 0,
+		MaxAllocated:     1024,
+	}}
+
+	want := `
+digraph {
+  0 [tables_in=0 rows_in=0 tables_out=2 rows_out=10 process="1ms" dispatch="0s" max_allocated=1024]
+  1
+
+  0 -> 1
+}
+`
+	if got := fmt.Sprintf("%v", plan.Formatted(spec, plan.WithNodeStatistics(stats))); got != want {
+		t.Errorf("unexpected formatted plan -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
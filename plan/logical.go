@@ -58,6 +58,7 @@ func (opt logicalOption) apply(lp *logicalPlanner) {
 type logicalPlanner struct {
 	*heuristicPlanner
 	disableIntegrityChecks bool
+	disableCSE             bool
 }
 
 // OnlyLogicalRules produces a logical plan option that forces only a set of particular rules to be
@@ -82,18 +83,35 @@ func DisableIntegrityChecks() LogicalOption {
 	})
 }
 
+// DisableCommonSubexpressionElimination disables the merging of identical
+// parts of the plan in the logical planner.
+func DisableCommonSubexpressionElimination() LogicalOption {
+	return logicalOption(func(lp *logicalPlanner) {
+		lp.disableCSE = true
+	})
+}
+
 // CreateInitialPlan translates the flux.Spec into an unoptimized, naive plan.
 func (l *logicalPlanner) CreateInitialPlan(spec *flux.Spec) (*Spec, error) {
 	return createLogicalPlan(spec)
 }
 
-// Plan transforms the given naive plan by applying rules.
+// Plan transforms the given naive plan by applying rules
+// and merging the parts of the plan that compute the same tables.
 func (l *logicalPlanner) Plan(logicalPlan *Spec) (*Spec, error) {
 	newLogicalPlan, err := l.heuristicPlanner.Plan(logicalPlan)
 	if err != nil {
 		return nil, err
 	}
 
+	// Merge the identical parts of the plan after the rules have
+	// been applied, so that the rules see each part of the plan separately.
+	if !l.disableCSE {
+		if err := eliminateCommonSubexpressions(newLogicalPlan); err != nil {
+			return nil, err
+		}
+	}
+
 	// check integrity after planning is complete
 	if !l.disableIntegrityChecks {
 		err := newLogicalPlan.CheckIntegrity()
@@ -106,13 +124,18 @@ func (l *logicalPlanner) Plan(logicalPlan *Spec) (*Spec, error) {
 }
 
 type administration struct {
-	now time.Time
+	now      time.Time
+	location flux.Location
 }
 
 func (a administration) Now() time.Time {
 	return a.now
 }
 
+func (a administration) Location() flux.Location {
+	return a.location
+}
+
 // LogicalNode consists of the input and output edges and a procedure spec
 // that describes what the node does.
 type LogicalNode struct {
@@ -153,7 +176,10 @@ func (lpn *LogicalNode) ShallowCopy() Node {
 // createLogicalPlan creates a logical query plan from a flux spec
 func createLogicalPlan(spec *flux.Spec) (*Spec, error) {
 	nodes := make(map[flux.OperationID]Node, len(spec.Operations))
-	admin := administration{now: spec.Now}
+	admin := administration{
+		now:      spec.Now,
+		location: spec.Location,
+	}
 
 	plan := NewPlanSpec()
 	plan.Resources = spec.Resources
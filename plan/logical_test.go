@@ -63,7 +63,7 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 			Bounds: flux.Bounds{
 				Start: flux.Time{
 					IsRelative: true,
-					Relative:   -1 * time.Hour,
+					Relative:   flux.ConvertDuration(-1 * time.Hour),
 				},
 				Stop: flux.Time{
 					IsRelative: true,
@@ -91,6 +91,7 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 		joinSpec = &universe.MergeJoinProcedureSpec{
 			TableNames: []string{"a", "b"},
 			On:         []string{"_time"},
+			Method:     "inner",
 		}
 		toHTTPSpec = &http.ToHTTPProcedureSpec{
 			Spec: &toHTTPOpSpec,
@@ -198,23 +199,17 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 				from(bucket: "my-bucket") |> range(start:-1h) |> http.to(url: "/my/url")
 				from(bucket: "my-bucket") |> range(start:-1h) |> kafka.to(brokers: ["broker"], topic: "topic")`,
 			plan: &plantest.PlanSpec{
+				// The plans share the same from and range.
 				Nodes: []plan.Node{
-					// First plan
 					plan.CreateLogicalNode("from0", fromSpec),
 					plan.CreateLogicalNode("range1", rangeSpec),
 					plan.CreateLogicalNode("toHTTP2", toHTTPSpec),
-					// Second plan
-					plan.CreateLogicalNode("from3", fromSpec),
-					plan.CreateLogicalNode("range4", rangeSpec),
 					plan.CreateLogicalNode("toKafka5", toKafkaSpec),
 				},
 				Edges: [][2]int{
-					// First plan
 					{0, 1},
 					{1, 2},
-					// Second plan
-					{3, 4},
-					{4, 5},
+					{1, 3},
 				},
 				Now: now,
 			},
@@ -226,23 +221,17 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 				from(bucket: "my-bucket") |> range(start:-1h) |> http.to(url: "/my/url")
 				from(bucket: "my-bucket") |> range(start:-1h)`,
 			plan: &plantest.PlanSpec{
+				// The plans share the same from and range.
 				Nodes: []plan.Node{
-					// First plan
-					plan.CreateLogicalNode("from0", fromSpec),
-					plan.CreateLogicalNode("range1", rangeSpec),
-					plan.CreateLogicalNode("toHTTP2", toHTTPSpec),
-					// Second plan
 					plan.CreateLogicalNode("from3", fromSpec),
 					plan.CreateLogicalNode("range4", rangeSpec),
+					plan.CreateLogicalNode("toHTTP2", toHTTPSpec),
 					plan.CreateLogicalNode("generated_yield", generatedYield("_result")),
 				},
 				Edges: [][2]int{
-					// First plan
 					{0, 1},
 					{1, 2},
-					// Second plan
-					{3, 4},
-					{4, 5},
+					{1, 3},
 				},
 				Now: now,
 			},
@@ -289,6 +278,88 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 				Now: now,
 			},
 		},
+		{
+			// yield    yield
+			//   |       |
+			//  sum     mean
+			//     \    /
+			//     filter
+			//       |
+			//     range
+			//       |
+			//     from
+			name: `common subexpression across yields`,
+			query: `
+				from(bucket: "my-bucket") |> range(start:-1h) |> filter(fn: (r) => true) |> sum() |> yield(name: "sum")
+				from(bucket: "my-bucket")
+					|> range(start:-1h)
+					|> filter(fn: (r) =>
+						true)
+					|> mean()
+					|> yield(name: "mean")`,
+			plan: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from0", fromSpec),
+					plan.CreateLogicalNode("range1", rangeSpec),
+					plan.CreateLogicalNode("filter2", filterSpec),
+					plan.CreateLogicalNode("sum3", sumSpec),
+					plan.CreateLogicalNode("yield4", standardYield("sum")),
+					plan.CreateLogicalNode("mean8", meanSpec),
+					plan.CreateLogicalNode("yield9", standardYield("mean")),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+					{2, 3},
+					{3, 4},
+					{2, 5},
+					{5, 6},
+				},
+				Now: now,
+			},
+		},
+		{
+			// A source is not shared by different successors.
+			name: `common source with different successors`,
+			query: `
+				from(bucket: "my-bucket") |> range(start:-1h) |> sum() |> yield(name: "sum")
+				from(bucket: "my-bucket") |> range(start:-2h) |> sum() |> yield(name: "sum2")`,
+			plan: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from0", fromSpec),
+					plan.CreateLogicalNode("range1", rangeSpec),
+					plan.CreateLogicalNode("sum2", sumSpec),
+					plan.CreateLogicalNode("yield3", standardYield("sum")),
+					plan.CreateLogicalNode("from4", fromSpec),
+					plan.CreateLogicalNode("range5", &universe.RangeProcedureSpec{
+						Bounds: flux.Bounds{
+							Start: flux.Time{
+								IsRelative: true,
+								Relative:   flux.ConvertDuration(-2 * time.Hour),
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							Now: now,
+						},
+						TimeColumn:  "_time",
+						StartColumn: "_start",
+						StopColumn:  "_stop",
+					}),
+					plan.CreateLogicalNode("sum6", sumSpec),
+					plan.CreateLogicalNode("yield7", standardYield("sum2")),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+					{2, 3},
+					{4, 5},
+					{5, 6},
+					{6, 7},
+				},
+				Now: now,
+			},
+		},
 		{
 			name: "multi-generated yields",
 			query: `
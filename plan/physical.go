@@ -17,8 +17,10 @@ type PhysicalPlanner interface {
 // The new plan will be configured to apply any physical rules that have been registered.
 func NewPhysicalPlanner(options ...PhysicalOption) PhysicalPlanner {
 	pp := &physicalPlanner{
-		heuristicPlanner:   newHeuristicPlanner(),
-		defaultMemoryLimit: math.MaxInt64,
+		heuristicPlanner:        newHeuristicPlanner(),
+		fusionPlanner:           newHeuristicPlanner(),
+		defaultMemoryLimit:      math.MaxInt64,
+		defaultMessageQueueSize: DefaultMessageQueueSize,
 	}
 
 	rules := make([]Rule, len(ruleNameToPhysicalRule))
@@ -32,6 +34,10 @@ func NewPhysicalPlanner(options ...PhysicalOption) PhysicalPlanner {
 
 	pp.addRules(physicalConverterRule{})
 
+	for _, v := range ruleNameToFusionRule {
+		pp.fusionPlanner.addRules(v)
+	}
+
 	// Options may add or remove rules, so process them after we've
 	// added registered rules.
 	for _, opt := range options {
@@ -47,6 +53,12 @@ func (pp *physicalPlanner) Plan(spec *Spec) (*Spec, error) {
 		return nil, err
 	}
 
+	// Fuse nodes only after every other rule has had the chance to rewrite them
+	transformedSpec, err = pp.fusionPlanner.Plan(transformedSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	// Compute time bounds for nodes in the plan
 	if err := transformedSpec.BottomUpWalk(ComputeBounds); err != nil {
 		return nil, err
@@ -80,6 +92,11 @@ func (pp *physicalPlanner) Plan(spec *Spec) (*Spec, error) {
 		transformedSpec.Resources.ConcurrencyQuota = len(transformedSpec.Roots)
 	}
 
+	// Update message queue size
+	if transformedSpec.MessageQueueSize == 0 {
+		transformedSpec.MessageQueueSize = pp.defaultMessageQueueSize
+	}
+
 	return transformedSpec, nil
 }
 
@@ -100,8 +117,10 @@ func validatePhysicalPlan(plan *Spec) error {
 
 type physicalPlanner struct {
 	*heuristicPlanner
-	defaultMemoryLimit int64
-	disableValidation  bool
+	fusionPlanner           *heuristicPlanner
+	defaultMemoryLimit      int64
+	defaultMessageQueueSize int
+	disableValidation       bool
 }
 
 // PhysicalOption is an option to configure the behavior of the physical plan.
@@ -123,10 +142,20 @@ func WithDefaultMemoryLimit(memBytes int64) PhysicalOption {
 	})
 }
 
+// WithDefaultMessageQueueSize sets the default size of the message queues for plans generated by the planner.
+// A smaller queue bounds the memory held by a slow transformation at the cost of throughput.
+// If the plan explicitly sets a message queue size, that size is used instead of the default.
+func WithDefaultMessageQueueSize(n int) PhysicalOption {
+	return physicalOption(func(p *physicalPlanner) {
+		p.defaultMessageQueueSize = n
+	})
+}
+
 // OnlyPhysicalRules produces a physical plan option that forces only a particular set of rules to be applied.
 func OnlyPhysicalRules(rules ...Rule) PhysicalOption {
 	return physicalOption(func(pp *physicalPlanner) {
 		pp.clearRules()
+		pp.fusionPlanner.clearRules()
 		// Always add physicalConverterRule. It doesn't change the plan but only convert nodes to physical.
 		// This is required for some pieces to work on the physical plan (e.g. SetTriggerSpec).
 		pp.addRules(physicalConverterRule{})
@@ -134,6 +163,14 @@ func OnlyPhysicalRules(rules ...Rule) PhysicalOption {
 	})
 }
 
+// DisableFusion produces a physical plan option that keeps the physical planner
+// from applying any of the registered fusion rules.
+func DisableFusion() PhysicalOption {
+	return physicalOption(func(pp *physicalPlanner) {
+		pp.fusionPlanner.clearRules()
+	})
+}
+
 // Disables validation in the physical planner
 func DisableValidation() PhysicalOption {
 	return physicalOption(func(p *physicalPlanner) {
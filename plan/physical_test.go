@@ -46,6 +46,54 @@ func TestPhysicalOptions(t *testing.T) {
 	}
 }
 
+func TestPhysicalMessageQueueSizeOption(t *testing.T) {
+	testcases := []struct {
+		name    string
+		options []plan.PhysicalOption
+		size    int
+		want    int
+	}{
+		{
+			name: "default",
+			want: plan.DefaultMessageQueueSize,
+		},
+		{
+			name:    "option",
+			options: []plan.PhysicalOption{plan.WithDefaultMessageQueueSize(8)},
+			want:    8,
+		},
+		{
+			name:    "plan overrides option",
+			options: []plan.PhysicalOption{plan.WithDefaultMessageQueueSize(8)},
+			size:    16,
+			want:    16,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			inputPlan := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plantest.CreatePhysicalMockNode("0"),
+					plantest.CreatePhysicalMockNode("1"),
+				},
+				Edges: [][2]int{
+					{0, 1},
+				},
+				MessageQueueSize: tc.size,
+			})
+
+			outputPlan, err := plan.NewPhysicalPlanner(tc.options...).Plan(inputPlan)
+			if err != nil {
+				t.Fatalf("Physical planning failed: %v", err)
+			}
+			if got := outputPlan.MessageQueueSize; got != tc.want {
+				t.Errorf("unexpected message queue size: want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestPhysicalIntegrityCheckOption(t *testing.T) {
 	node0 := plantest.CreatePhysicalMockNode("0")
 	node1 := plantest.CreatePhysicalMockNode("1")
@@ -20,11 +20,15 @@ type PlanSpec struct {
 	Resources flux.ResourceManagement
 
 	Now time.Time
+
+	MessageQueueSize int
 }
 
 // CreatePlanSpec creates a logical plan from a set of nodes and edges
 func CreatePlanSpec(spec *PlanSpec) *plan.Spec {
-	return createPlanSpec(spec.Nodes, spec.Edges, spec.Resources, spec.Now)
+	ps := createPlanSpec(spec.Nodes, spec.Edges, spec.Resources, spec.Now)
+	ps.MessageQueueSize = spec.MessageQueueSize
+	return ps
 }
 
 // Copy makes a copy of a Spec.
@@ -40,6 +44,7 @@ func (ps *PlanSpec) Copy() *PlanSpec {
 	copy(cps.Edges, ps.Edges)
 	cps.Resources = ps.Resources
 	cps.Now = ps.Now
+	cps.MessageQueueSize = ps.MessageQueueSize
 	return cps
 }
 
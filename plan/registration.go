@@ -9,6 +9,7 @@ import (
 
 type Administration interface {
 	Now() time.Time
+	Location() flux.Location
 }
 
 // CreateProcedureSpec creates a ProcedureSpec from an OperationSpec and Administration
@@ -72,6 +73,7 @@ func HasSideEffect(spec ProcedureSpec) bool {
 
 var ruleNameToLogicalRule = make(map[string]Rule)
 var ruleNameToPhysicalRule = make(map[string]Rule)
+var ruleNameToFusionRule = make(map[string]Rule)
 
 // RegisterLogicalRules registers the rule created by createFn with the logical plan.
 func RegisterLogicalRules(rules ...Rule) {
@@ -83,6 +85,13 @@ func RegisterPhysicalRules(rules ...Rule) {
 	registerRule(ruleNameToPhysicalRule, rules...)
 }
 
+// RegisterFusionRules registers rules that the physical planner applies once no other physical rule applies.
+// A fusion rule merges nodes that other rules would otherwise rewrite individually, such as a filter
+// that could be pushed down into its source, so it must only see the plan those rules leave behind.
+func RegisterFusionRules(rules ...Rule) {
+	registerRule(ruleNameToFusionRule, rules...)
+}
+
 func registerRule(ruleMap map[string]Rule, rules ...Rule) {
 	for _, rule := range rules {
 		name := rule.Name()
@@ -0,0 +1,53 @@
+package plan
+
+import "fmt"
+
+// Projection selects the columns of the tables produced by a source.
+// It keeps the named columns like keep(columns: ...),
+// or removes them like drop(columns: ...) when Drop is set.
+type Projection struct {
+	Columns []string
+	Drop    bool
+}
+
+// Select reports which of the columns with the given labels remain after the projection.
+// Like keep, it returns an error if a column that is kept does not exist.
+func (p Projection) Select(labels []string) ([]bool, error) {
+	named := make(map[string]bool, len(p.Columns))
+	for _, c := range p.Columns {
+		named[c] = true
+	}
+	exists := make(map[string]bool, len(labels))
+	selected := make([]bool, len(labels))
+	for i, label := range labels {
+		selected[i] = named[label] != p.Drop
+		exists[label] = true
+	}
+	if !p.Drop {
+		for _, c := range p.Columns {
+			if !exists[c] {
+				return nil, fmt.Errorf(`keep error: column "%s" doesn't exist`, c)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// ProjectionSource is implemented by the procedure spec of a source
+// that can leave some of the columns out of its tables,
+// so that the columns are never read.
+type ProjectionSource interface {
+	// PushDownProjection returns a copy of the spec that applies the projection
+	// to the tables it produces, or false if the source cannot apply it.
+	PushDownProjection(p Projection) (ProcedureSpec, bool)
+}
+
+// LimitSource is implemented by the procedure spec of a source
+// that can produce only some of the rows of each of its tables,
+// so that the other rows are never read.
+type LimitSource interface {
+	// PushDownLimit returns a copy of the spec that produces at most n rows of each table,
+	// after skipping the first offset rows, or false if the source cannot apply the limit.
+	// The limit n is always positive.
+	PushDownLimit(n, offset int64) (ProcedureSpec, bool)
+}
@@ -0,0 +1,57 @@
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/plan"
+)
+
+func TestProjection_Select(t *testing.T) {
+	labels := []string{"_time", "host", "_value"}
+	testcases := []struct {
+		name       string
+		projection plan.Projection
+		want       []bool
+		wantErr    string
+	}{
+		{
+			name:       "keep",
+			projection: plan.Projection{Columns: []string{"_value", "_time"}},
+			want:       []bool{true, false, true},
+		},
+		{
+			name:       "drop",
+			projection: plan.Projection{Columns: []string{"host", "region"}, Drop: true},
+			want:       []bool{true, false, true},
+		},
+		{
+			name:       "keep nothing",
+			projection: plan.Projection{Columns: []string{}},
+			want:       []bool{false, false, false},
+		},
+		{
+			name:       "keep missing column",
+			projection: plan.Projection{Columns: []string{"host", "region"}},
+			wantErr:    `keep error: column "region" doesn't exist`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.projection.Select(labels)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected columns -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
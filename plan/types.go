@@ -54,8 +54,17 @@ type Spec struct {
 	Roots     map[Node]struct{}
 	Resources flux.ResourceManagement
 	Now       time.Time
+
+	// MessageQueueSize is the number of messages that may be queued for
+	// a transformation before the nodes sending to it must wait.
+	// A zero value uses DefaultMessageQueueSize.
+	MessageQueueSize int
 }
 
+// DefaultMessageQueueSize is the size of the message queues
+// of a plan that does not specify one.
+const DefaultMessageQueueSize = 64
+
 // NewPlanSpec initializes a new query plan
 func NewPlanSpec() *Spec {
 	return &Spec{
@@ -329,7 +338,8 @@ func ReplaceNode(oldNode, newNode Node) {
 }
 
 type WindowSpec struct {
-	Every  flux.Duration
-	Period flux.Duration
-	Offset flux.Duration
+	Every    flux.Duration
+	Period   flux.Duration
+	Offset   flux.Duration
+	Location flux.Location
 }
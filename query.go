@@ -82,6 +82,33 @@ type Statistics struct {
 
 	// Metadata contains metadata key/value pairs that have been attached during execution.
 	Metadata Metadata `json:"metadata"`
+
+	// Nodes contains the runtime statistics of each node in the plan of the query.
+	Nodes []NodeStatistics `json:"nodes"`
+}
+
+// NodeStatistics is a collection of runtime statistics about a single node in the plan of a query.
+type NodeStatistics struct {
+	// ID is the ID of the plan node.
+	ID string `json:"id"`
+	// Kind is the procedure kind of the plan node.
+	Kind string `json:"kind"`
+
+	// TablesIn is the number of tables the node received.
+	TablesIn int64 `json:"tables_in"`
+	// RowsIn is the number of rows the node read from the tables it received.
+	RowsIn int64 `json:"rows_in"`
+	// TablesOut is the number of tables the node produced.
+	TablesOut int64 `json:"tables_out"`
+	// RowsOut is the number of rows read from the tables the node produced.
+	RowsOut int64 `json:"rows_out"`
+
+	// ProcessDuration is the amount of time in nanoseconds the node spent processing.
+	ProcessDuration time.Duration `json:"process_duration"`
+	// DispatchDuration is the amount of time in nanoseconds the node spent waiting on the dispatcher.
+	DispatchDuration time.Duration `json:"dispatch_duration"`
+	// MaxAllocated is the maximum number of bytes allocated by the node.
+	MaxAllocated int64 `json:"max_allocated"`
 }
 
 // Add returns the sum of s and other.
@@ -92,6 +119,9 @@ func (s Statistics) Add(other Statistics) Statistics {
 	md := make(Metadata)
 	md.AddAll(s.Metadata)
 	md.AddAll(other.Metadata)
+	nodes := make([]NodeStatistics, len(s.Nodes), len(s.Nodes)+len(other.Nodes))
+	copy(nodes, s.Nodes)
+	nodes = append(nodes, other.Nodes...)
 	return Statistics{
 		TotalDuration:   s.TotalDuration + other.TotalDuration,
 		CompileDuration: s.CompileDuration + other.CompileDuration,
@@ -103,5 +133,6 @@ func (s Statistics) Add(other Statistics) Statistics {
 		RuntimeErrors:   errs,
 		MaxAllocated:    s.MaxAllocated + other.MaxAllocated,
 		Metadata:        md,
+		Nodes:           nodes,
 	}
 }
@@ -177,6 +177,13 @@ func (r *REPL) executeLine(t string) (values.Value, error) {
 			return nil, err
 		}
 		s := spec.FromTableObject(t, nowTime.Time().Time())
+		if location, ok := r.scope.Lookup("location"); ok {
+			l, err := flux.LocationFromValue(location)
+			if err != nil {
+				return nil, err
+			}
+			s.Location = l
+		}
 		return nil, r.doQuery(s)
 	}
 
@@ -17,6 +17,9 @@ type Result interface {
 }
 
 type TableIterator interface {
+	// Do calls f with each table of the iterator.
+	// The iterator may release a table once f returns, so a table
+	// must not be used after that unless f retains it with RefCount.
 	Do(f func(Table) error) error
 }
 
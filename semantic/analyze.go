@@ -3,7 +3,6 @@ package semantic
 import (
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/influxdata/flux/ast"
 )
@@ -621,13 +620,14 @@ func analyzeDateTimeLiteral(lit *ast.DateTimeLiteral) (*DateTimeLiteral, error)
 	}, nil
 }
 func analyzeDurationLiteral(lit *ast.DurationLiteral) (*DurationLiteral, error) {
-	duration, err := ast.DurationFrom(lit, time.Time{})
+	months, duration, err := ast.MonthsFrom(lit)
 	if err != nil {
 		return nil, err
 	}
 	return &DurationLiteral{
-		loc:   loc(lit.Location()),
-		Value: duration,
+		loc:    loc(lit.Location()),
+		Months: months,
+		Value:  duration,
 	}, nil
 }
 func analyzeFloatLiteral(lit *ast.FloatLiteral) (*FloatLiteral, error) {
@@ -891,6 +891,9 @@ func (l *DateTimeLiteral) Copy() Node {
 type DurationLiteral struct {
 	loc `json:"-"`
 
+	// Months is the calendar month component of the duration.
+	Months int64 `json:"months,omitempty"`
+	// Value is the exact component of the duration.
 	Value time.Duration `json:"value"`
 }
 
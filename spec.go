@@ -13,6 +13,7 @@ type Spec struct {
 	Edges      []Edge             `json:"edges"`
 	Resources  ResourceManagement `json:"resources"`
 	Now        time.Time          `json:"now"`
+	Location   Location           `json:"location"`
 
 	sorted   []*Operation
 	children map[OperationID][]*Operation
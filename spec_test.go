@@ -76,7 +76,7 @@ func TestSpec_JSON(t *testing.T) {
 				ID: "range",
 				Spec: &universe.RangeOpSpec{
 					Start: flux.Time{
-						Relative:   -4 * time.Hour,
+						Relative:   flux.ConvertDuration(-4 * time.Hour),
 						IsRelative: true,
 					},
 					Stop: flux.Time{
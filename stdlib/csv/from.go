@@ -79,9 +79,41 @@ func (s *FromCSVOpSpec) Kind() flux.OperationKind {
 }
 
 type FromCSVProcedureSpec struct {
-	plan.DefaultCost
 	CSV  string
 	File string
+
+	// Projection is pushed down from keep or drop.
+	// All of the columns are decoded if it is nil.
+	Projection *plan.Projection
+	// Limit and Offset are pushed down from limit.
+	// All of the rows are decoded if Limit is zero.
+	Limit  int64
+	Offset int64
+}
+
+// Cost estimates the rows of an inline CSV from the number of data lines it contains.
+// The rows of a file are not known until it is read.
+func (s *FromCSVProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	if s.CSV == "" {
+		return plan.DefaultCost{}.Cost(inStats)
+	}
+	var rows int64
+	for _, line := range strings.Split(s.CSV, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			rows++
+		}
+	}
+	if rows > 0 {
+		// The first line of data is the header.
+		rows--
+	}
+	if s.Limit > 0 && rows > s.Limit {
+		rows = s.Limit
+	}
+	return plan.Cost{Disk: int64(len(s.CSV)), CPU: rows}, plan.Statistics{
+		Cardinality:      rows,
+		GroupCardinality: 1,
+	}
 }
 
 func newFromCSVProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -104,9 +136,41 @@ func (s *FromCSVProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromCSVProcedureSpec)
 	ns.CSV = s.CSV
 	ns.File = s.File
+	if s.Projection != nil {
+		ns.Projection = &plan.Projection{
+			Columns: make([]string, len(s.Projection.Columns)),
+			Drop:    s.Projection.Drop,
+		}
+		copy(ns.Projection.Columns, s.Projection.Columns)
+	}
+	ns.Limit = s.Limit
+	ns.Offset = s.Offset
 	return ns
 }
 
+// PushDownProjection decodes only the columns selected by the projection.
+// Only one projection is pushed down.
+func (s *FromCSVProcedureSpec) PushDownProjection(p plan.Projection) (plan.ProcedureSpec, bool) {
+	if s.Projection != nil {
+		return nil, false
+	}
+	ns := s.Copy().(*FromCSVProcedureSpec)
+	ns.Projection = &p
+	return ns, true
+}
+
+// PushDownLimit decodes only the rows of each table within the limit.
+// Only one limit is pushed down.
+func (s *FromCSVProcedureSpec) PushDownLimit(n, offset int64) (plan.ProcedureSpec, bool) {
+	if s.Limit > 0 {
+		return nil, false
+	}
+	ns := s.Copy().(*FromCSVProcedureSpec)
+	ns.Limit = n
+	ns.Offset = offset
+	return ns, true
+}
+
 func createFromCSVSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := prSpec.(*FromCSVProcedureSpec)
 	if !ok {
@@ -122,15 +186,26 @@ func createFromCSVSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a ex
 		}
 		csvText = string(csvBytes)
 	}
-	csvSource := CSVSource{id: dsid, tx: csvText}
+	csvSource := CSVSource{
+		id: dsid,
+		tx: csvText,
+		config: csv.ResultDecoderConfig{
+			Limit:  spec.Limit,
+			Offset: spec.Offset,
+		},
+	}
+	if spec.Projection != nil {
+		csvSource.config.Columns = spec.Projection.Select
+	}
 
 	return &csvSource, nil
 }
 
 type CSVSource struct {
-	id execute.DatasetID
-	tx string
-	ts []execute.Transformation
+	id     execute.DatasetID
+	tx     string
+	config csv.ResultDecoderConfig
+	ts     []execute.Transformation
 }
 
 func (c *CSVSource) AddTransformation(t execute.Transformation) {
@@ -147,7 +222,7 @@ func (c *CSVSource) Run(ctx context.Context) {
 		// transformation. Unlike other sources, tables from csv sources
 		// are not read-only. They contain mutable state and therefore
 		// cannot be shared among goroutines.
-		decoder := csv.NewResultDecoder(csv.ResultDecoderConfig{})
+		decoder := csv.NewResultDecoder(c.config)
 		result, decodeErr := decoder.Decode(strings.NewReader(c.tx))
 		if decodeErr != nil {
 			err = decodeErr
@@ -7,6 +7,8 @@ import (
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/csv"
 	"github.com/influxdata/flux/stdlib/universe"
@@ -49,11 +51,11 @@ func TestFromCSV_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -4 * time.Hour,
+								Relative:   flux.ConvertDuration(-4 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
-								Relative:   -2 * time.Hour,
+								Relative:   flux.ConvertDuration(-2 * time.Hour),
 								IsRelative: true,
 							},
 							TimeColumn:  "_time",
@@ -99,3 +101,73 @@ func TestFromCSVOperation_Marshaling(t *testing.T) {
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
+
+func TestFromCSV_PushDown(t *testing.T) {
+	from := &csv.FromCSVProcedureSpec{File: "data.csv"}
+	keep := &universe.SchemaMutationProcedureSpec{
+		Mutations: []universe.SchemaMutation{
+			&universe.KeepOpSpec{Columns: []string{"_time", "_value"}},
+			&universe.DropOpSpec{Columns: []string{"_time"}},
+		},
+	}
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "projection and limit",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}, universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("keep", keep),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10, Offset: 1}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					// Only the first projection is pushed down, so the limit
+					// is applied after the remaining mutation.
+					plan.CreatePhysicalNode("from", &csv.FromCSVProcedureSpec{
+						File:       "data.csv",
+						Projection: &plan.Projection{Columns: []string{"_time", "_value"}},
+					}),
+					plan.CreatePhysicalNode("keep", &universe.SchemaMutationProcedureSpec{
+						Mutations: []universe.SchemaMutation{
+							&universe.DropOpSpec{Columns: []string{"_time"}},
+						},
+					}),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10, Offset: 1}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+		},
+		{
+			Name:  "limit",
+			Rules: []plan.Rule{universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10, Offset: 1}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &csv.FromCSVProcedureSpec{
+						File:   "data.csv",
+						Limit:  10,
+						Offset: 1,
+					}),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package date
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	timeArg     = "t"
+	unitArg     = "unit"
+	locationArg = "location"
+)
+
+// locationDefaults defaults the location argument to the location option of the caller.
+var locationDefaults = map[string]string{locationArg: "location"}
+
+// generateTimePartFunction returns a function that extracts an integer part of a time
+// in the wall clock time of the optional location argument.
+func generateTimePartFunction(name string, partFn func(time.Time) int) values.Function {
+	return interpreter.WithOptionDefaults(values.NewFunction(
+		name,
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				timeArg:     semantic.Time,
+				locationArg: flux.LocationPolyType,
+			},
+			Required: semantic.LabelSet{timeArg},
+			Return:   semantic.Int,
+		}),
+		func(args values.Object) (values.Value, error) {
+			t, err := getTime(args)
+			if err != nil {
+				return nil, err
+			}
+			loc, err := getLocation(args)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewInt(int64(partFn(t.Time().In(loc)))), nil
+		}, false,
+	), locationDefaults)
+}
+
+// truncate rounds a time down to a multiple of a unit in the wall clock time of the optional location argument.
+var truncate = interpreter.WithOptionDefaults(values.NewFunction(
+	"truncate",
+	semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			timeArg:     semantic.Time,
+			unitArg:     semantic.Duration,
+			locationArg: flux.LocationPolyType,
+		},
+		Required: semantic.LabelSet{timeArg, unitArg},
+		Return:   semantic.Time,
+	}),
+	func(args values.Object) (values.Value, error) {
+		t, err := getTime(args)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := args.Get(unitArg)
+		if !ok {
+			return nil, fmt.Errorf("missing argument %q", unitArg)
+		}
+		if v.Type().Nature() != semantic.Duration {
+			return nil, fmt.Errorf("expected argument %q to be of type %v, got type %v", unitArg, semantic.Duration, v.Type().Nature())
+		}
+		unit := v.Duration()
+		if !unit.IsPositive() {
+			return nil, fmt.Errorf("argument %q must be a positive duration, got %v", unitArg, unit)
+		}
+		loc, err := getLocation(args)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewTime(t.TruncateIn(unit, loc)), nil
+	}, false,
+), locationDefaults)
+
+func getTime(args values.Object) (values.Time, error) {
+	v, ok := args.Get(timeArg)
+	if !ok {
+		return 0, fmt.Errorf("missing argument %q", timeArg)
+	}
+	if v.Type().Nature() != semantic.Time {
+		return 0, fmt.Errorf("expected argument %q to be of type %v, got type %v", timeArg, semantic.Time, v.Type().Nature())
+	}
+	return v.Time(), nil
+}
+
+// getLocation returns the time zone of the location argument.
+// Calls from Flux default the argument to the location option,
+// so UTC is only used when the function is called without it from Go.
+func getLocation(args values.Object) (*time.Location, error) {
+	v, ok := args.Get(locationArg)
+	if !ok {
+		return time.UTC, nil
+	}
+	l, err := flux.LocationFromValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return l.Load()
+}
+
+func init() {
+	flux.RegisterPackageValue("date", "second", generateTimePartFunction("second", time.Time.Second))
+	flux.RegisterPackageValue("date", "minute", generateTimePartFunction("minute", time.Time.Minute))
+	flux.RegisterPackageValue("date", "hour", generateTimePartFunction("hour", time.Time.Hour))
+	flux.RegisterPackageValue("date", "weekDay", generateTimePartFunction("weekDay", func(t time.Time) int { return int(t.Weekday()) }))
+	flux.RegisterPackageValue("date", "monthDay", generateTimePartFunction("monthDay", time.Time.Day))
+	flux.RegisterPackageValue("date", "yearDay", generateTimePartFunction("yearDay", time.Time.YearDay))
+	flux.RegisterPackageValue("date", "month", generateTimePartFunction("month", func(t time.Time) int { return int(t.Month()) }))
+	flux.RegisterPackageValue("date", "truncate", truncate)
+}
@@ -0,0 +1,138 @@
+package date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/values"
+)
+
+func TestTimePartFunctions(t *testing.T) {
+	ts := time.Date(2019, time.March, 10, 1, 30, 45, 0, time.UTC)
+	denver, err := flux.LoadLocation("America/Denver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name     string
+		fn       values.Function
+		location *flux.Location
+		want     int64
+	}{
+		{name: "second", fn: generateTimePartFunction("second", time.Time.Second), want: 45},
+		{name: "minute", fn: generateTimePartFunction("minute", time.Time.Minute), want: 30},
+		{name: "hour", fn: generateTimePartFunction("hour", time.Time.Hour), want: 1},
+		{name: "weekDay", fn: generateTimePartFunction("weekDay", func(t time.Time) int { return int(t.Weekday()) }), want: 0},
+		{name: "monthDay", fn: generateTimePartFunction("monthDay", time.Time.Day), want: 10},
+		{name: "yearDay", fn: generateTimePartFunction("yearDay", time.Time.YearDay), want: 69},
+		{name: "month", fn: generateTimePartFunction("month", func(t time.Time) int { return int(t.Month()) }), want: 3},
+		{name: "hour in location", fn: generateTimePartFunction("hour", time.Time.Hour), location: &denver, want: 18},
+		{name: "weekDay in location", fn: generateTimePartFunction("weekDay", func(t time.Time) int { return int(t.Weekday()) }), location: &denver, want: 6},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := map[string]values.Value{
+				"t": values.NewTime(values.ConvertTime(ts)),
+			}
+			if tc.location != nil {
+				args["location"] = tc.location.Value()
+			}
+			result, err := tc.fn.Call(values.NewObjectWithValues(args))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := result.Int(); got != tc.want {
+				t.Errorf("date function result %s expected: %d, got: %d", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	denver, err := flux.LoadLocation("America/Denver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name     string
+		t        string
+		unit     values.Duration
+		location *flux.Location
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "second",
+			t:    "2019-03-10T01:30:45.5Z",
+			unit: values.ConvertDuration(time.Second),
+			want: "2019-03-10T01:30:45Z",
+		},
+		{
+			name: "hour",
+			t:    "2019-03-10T01:30:45Z",
+			unit: values.ConvertDuration(time.Hour),
+			want: "2019-03-10T01:00:00Z",
+		},
+		{
+			name: "day",
+			t:    "2019-03-10T01:30:45Z",
+			unit: values.ConvertDuration(24 * time.Hour),
+			want: "2019-03-10T00:00:00Z",
+		},
+		{
+			name: "month",
+			t:    "2019-03-10T01:30:45Z",
+			unit: values.MakeDuration(0, 1),
+			want: "2019-03-01T00:00:00Z",
+		},
+		{
+			name: "year",
+			t:    "2019-03-10T01:30:45Z",
+			unit: values.MakeDuration(0, 12),
+			want: "2019-01-01T00:00:00Z",
+		},
+		{
+			name:     "day in location",
+			t:        "2019-03-10T01:30:45Z",
+			unit:     values.ConvertDuration(24 * time.Hour),
+			location: &denver,
+			want:     "2019-03-09T07:00:00Z",
+		},
+		{
+			name:    "zero unit",
+			t:       "2019-03-10T01:30:45Z",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ts, err := time.Parse(time.RFC3339Nano, tc.t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			args := map[string]values.Value{
+				"t":    values.NewTime(values.ConvertTime(ts)),
+				"unit": values.NewDuration(tc.unit),
+			}
+			if tc.location != nil {
+				args["location"] = tc.location.Value()
+			}
+			result, err := truncate.Call(values.NewObjectWithValues(args))
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			if got := result.Time().Time().Format(time.RFC3339Nano); got != tc.want {
+				t.Errorf("truncate result %s expected: %s, got: %s", tc.name, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,816 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package date
+
+import (
+	flux "github.com/influxdata/flux"
+	ast "github.com/influxdata/flux/ast"
+)
+
+func init() {
+	flux.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 2,
+					Line:   23,
+				},
+				File:   "date.flux",
+				Source: "package date\n\n// Time and date functions\nbuiltin second\nbuiltin minute\nbuiltin hour\nbuiltin weekDay\nbuiltin monthDay\nbuiltin yearDay\nbuiltin month\nbuiltin truncate\n\n// hack to simulate an imported date package\ndate = {\n  second:second,\n  minute:minute,\n  hour:hour,\n  weekDay:weekDay,\n  monthDay:monthDay,\n  yearDay:yearDay,\n  month:month,\n  truncate:truncate,\n}",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   4,
+					},
+					File:   "date.flux",
+					Source: "builtin second",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   4,
+						},
+						File:   "date.flux",
+						Source: "second",
+						Start: ast.Position{
+							Column: 9,
+							Line:   4,
+						},
+					},
+				},
+				Name: "second",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   5,
+					},
+					File:   "date.flux",
+					Source: "builtin minute",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   5,
+						},
+						File:   "date.flux",
+						Source: "minute",
+						Start: ast.Position{
+							Column: 9,
+							Line:   5,
+						},
+					},
+				},
+				Name: "minute",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   6,
+					},
+					File:   "date.flux",
+					Source: "builtin hour",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   6,
+						},
+						File:   "date.flux",
+						Source: "hour",
+						Start: ast.Position{
+							Column: 9,
+							Line:   6,
+						},
+					},
+				},
+				Name: "hour",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 16,
+						Line:   7,
+					},
+					File:   "date.flux",
+					Source: "builtin weekDay",
+					Start: ast.Position{
+						Column: 1,
+						Line:   7,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   7,
+						},
+						File:   "date.flux",
+						Source: "weekDay",
+						Start: ast.Position{
+							Column: 9,
+							Line:   7,
+						},
+					},
+				},
+				Name: "weekDay",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   8,
+					},
+					File:   "date.flux",
+					Source: "builtin monthDay",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   8,
+						},
+						File:   "date.flux",
+						Source: "monthDay",
+						Start: ast.Position{
+							Column: 9,
+							Line:   8,
+						},
+					},
+				},
+				Name: "monthDay",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 16,
+						Line:   9,
+					},
+					File:   "date.flux",
+					Source: "builtin yearDay",
+					Start: ast.Position{
+						Column: 1,
+						Line:   9,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   9,
+						},
+						File:   "date.flux",
+						Source: "yearDay",
+						Start: ast.Position{
+							Column: 9,
+							Line:   9,
+						},
+					},
+				},
+				Name: "yearDay",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   10,
+					},
+					File:   "date.flux",
+					Source: "builtin month",
+					Start: ast.Position{
+						Column: 1,
+						Line:   10,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   10,
+						},
+						File:   "date.flux",
+						Source: "month",
+						Start: ast.Position{
+							Column: 9,
+							Line:   10,
+						},
+					},
+				},
+				Name: "month",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   11,
+					},
+					File:   "date.flux",
+					Source: "builtin truncate",
+					Start: ast.Position{
+						Column: 1,
+						Line:   11,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   11,
+						},
+						File:   "date.flux",
+						Source: "truncate",
+						Start: ast.Position{
+							Column: 9,
+							Line:   11,
+						},
+					},
+				},
+				Name: "truncate",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   23,
+					},
+					File:   "date.flux",
+					Source: "date = {\n  second:second,\n  minute:minute,\n  hour:hour,\n  weekDay:weekDay,\n  monthDay:monthDay,\n  yearDay:yearDay,\n  month:month,\n  truncate:truncate,\n}",
+					Start: ast.Position{
+						Column: 1,
+						Line:   14,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 5,
+							Line:   14,
+						},
+						File:   "date.flux",
+						Source: "date",
+						Start: ast.Position{
+							Column: 1,
+							Line:   14,
+						},
+					},
+				},
+				Name: "date",
+			},
+			Init: &ast.ObjectExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   23,
+						},
+						File:   "date.flux",
+						Source: "{\n  second:second,\n  minute:minute,\n  hour:hour,\n  weekDay:weekDay,\n  monthDay:monthDay,\n  yearDay:yearDay,\n  month:month,\n  truncate:truncate,\n}",
+						Start: ast.Position{
+							Column: 8,
+							Line:   14,
+						},
+					},
+				},
+				Properties: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   15,
+							},
+							File:   "date.flux",
+							Source: "second:second",
+							Start: ast.Position{
+								Column: 3,
+								Line:   15,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   15,
+								},
+								File:   "date.flux",
+								Source: "second",
+								Start: ast.Position{
+									Column: 3,
+									Line:   15,
+								},
+							},
+						},
+						Name: "second",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   15,
+								},
+								File:   "date.flux",
+								Source: "second",
+								Start: ast.Position{
+									Column: 10,
+									Line:   15,
+								},
+							},
+						},
+						Name: "second",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   16,
+							},
+							File:   "date.flux",
+							Source: "minute:minute",
+							Start: ast.Position{
+								Column: 3,
+								Line:   16,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   16,
+								},
+								File:   "date.flux",
+								Source: "minute",
+								Start: ast.Position{
+									Column: 3,
+									Line:   16,
+								},
+							},
+						},
+						Name: "minute",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   16,
+								},
+								File:   "date.flux",
+								Source: "minute",
+								Start: ast.Position{
+									Column: 10,
+									Line:   16,
+								},
+							},
+						},
+						Name: "minute",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   17,
+							},
+							File:   "date.flux",
+							Source: "hour:hour",
+							Start: ast.Position{
+								Column: 3,
+								Line:   17,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 7,
+									Line:   17,
+								},
+								File:   "date.flux",
+								Source: "hour",
+								Start: ast.Position{
+									Column: 3,
+									Line:   17,
+								},
+							},
+						},
+						Name: "hour",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 12,
+									Line:   17,
+								},
+								File:   "date.flux",
+								Source: "hour",
+								Start: ast.Position{
+									Column: 8,
+									Line:   17,
+								},
+							},
+						},
+						Name: "hour",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   18,
+							},
+							File:   "date.flux",
+							Source: "weekDay:weekDay",
+							Start: ast.Position{
+								Column: 3,
+								Line:   18,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 10,
+									Line:   18,
+								},
+								File:   "date.flux",
+								Source: "weekDay",
+								Start: ast.Position{
+									Column: 3,
+									Line:   18,
+								},
+							},
+						},
+						Name: "weekDay",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 18,
+									Line:   18,
+								},
+								File:   "date.flux",
+								Source: "weekDay",
+								Start: ast.Position{
+									Column: 11,
+									Line:   18,
+								},
+							},
+						},
+						Name: "weekDay",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   19,
+							},
+							File:   "date.flux",
+							Source: "monthDay:monthDay",
+							Start: ast.Position{
+								Column: 3,
+								Line:   19,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 11,
+									Line:   19,
+								},
+								File:   "date.flux",
+								Source: "monthDay",
+								Start: ast.Position{
+									Column: 3,
+									Line:   19,
+								},
+							},
+						},
+						Name: "monthDay",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   19,
+								},
+								File:   "date.flux",
+								Source: "monthDay",
+								Start: ast.Position{
+									Column: 12,
+									Line:   19,
+								},
+							},
+						},
+						Name: "monthDay",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   20,
+							},
+							File:   "date.flux",
+							Source: "yearDay:yearDay",
+							Start: ast.Position{
+								Column: 3,
+								Line:   20,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 10,
+									Line:   20,
+								},
+								File:   "date.flux",
+								Source: "yearDay",
+								Start: ast.Position{
+									Column: 3,
+									Line:   20,
+								},
+							},
+						},
+						Name: "yearDay",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 18,
+									Line:   20,
+								},
+								File:   "date.flux",
+								Source: "yearDay",
+								Start: ast.Position{
+									Column: 11,
+									Line:   20,
+								},
+							},
+						},
+						Name: "yearDay",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 14,
+								Line:   21,
+							},
+							File:   "date.flux",
+							Source: "month:month",
+							Start: ast.Position{
+								Column: 3,
+								Line:   21,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 8,
+									Line:   21,
+								},
+								File:   "date.flux",
+								Source: "month",
+								Start: ast.Position{
+									Column: 3,
+									Line:   21,
+								},
+							},
+						},
+						Name: "month",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 14,
+									Line:   21,
+								},
+								File:   "date.flux",
+								Source: "month",
+								Start: ast.Position{
+									Column: 9,
+									Line:   21,
+								},
+							},
+						},
+						Name: "month",
+					},
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   22,
+							},
+							File:   "date.flux",
+							Source: "truncate:truncate",
+							Start: ast.Position{
+								Column: 3,
+								Line:   22,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 11,
+									Line:   22,
+								},
+								File:   "date.flux",
+								Source: "truncate",
+								Start: ast.Position{
+									Column: 3,
+									Line:   22,
+								},
+							},
+						},
+						Name: "truncate",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   22,
+								},
+								File:   "date.flux",
+								Source: "truncate",
+								Start: ast.Position{
+									Column: 12,
+									Line:   22,
+								},
+							},
+						},
+						Name: "truncate",
+					},
+				}},
+			},
+		}},
+		Imports: nil,
+		Name:    "date.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   1,
+					},
+					File:   "date.flux",
+					Source: "package date",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   1,
+						},
+						File:   "date.flux",
+						Source: "date",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "date",
+			},
+		},
+	}},
+	Package: "date",
+	Path:    "date",
+}
@@ -0,0 +1,93 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/compiler"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestLocationOption(t *testing.T) {
+	ts := time.Date(2019, time.March, 10, 1, 30, 45, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		option   string
+		wantHour int64
+		wantDay  time.Time
+	}{
+		{
+			name:     "default",
+			wantHour: 1,
+			wantDay:  time.Date(2019, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "load location",
+			option:   `option location = loadLocation(name: "America/Denver")`,
+			wantHour: 18,
+			wantDay:  time.Date(2019, time.March, 9, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "fixed zone",
+			option:   `option location = fixedZone(offset: 5h)`,
+			wantHour: 6,
+			wantDay:  time.Date(2019, time.March, 9, 19, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, scope, err := flux.Eval(`
+import "date"
+` + tc.option + `
+t = 2019-03-10T01:30:45Z
+hour = date.hour(t: t)
+day = date.truncate(t: t, unit: 1d)
+f = (r) => ({hour: date.hour(t: r.t), day: date.truncate(t: r.t, unit: 1d)})
+`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check := func(hour, day values.Value) {
+				t.Helper()
+				if got := hour.Int(); got != tc.wantHour {
+					t.Errorf("unexpected hour -want/+got:\n\t- %d\n\t+ %d", tc.wantHour, got)
+				}
+				if got := day.Time().Time(); !got.Equal(tc.wantDay) {
+					t.Errorf("unexpected day -want/+got:\n\t- %v\n\t+ %v", tc.wantDay, got)
+				}
+			}
+
+			hour, _ := scope.Lookup("hour")
+			day, _ := scope.Lookup("day")
+			check(hour, day)
+
+			// Functions compiled from lambdas use the option of the scope they were defined in.
+			f, _ := scope.Lookup("f")
+			fn, err := interpreter.ResolveFunction(f.Function())
+			if err != nil {
+				t.Fatal(err)
+			}
+			compiled, err := compiler.Compile(fn, semantic.NewObjectType(map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{"t": semantic.Time}),
+			}), flux.BuiltIns())
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := values.NewObjectWithValues(map[string]values.Value{
+				"t": values.NewTime(values.ConvertTime(ts)),
+			})
+			v, err := compiled.Eval(values.NewObjectWithValues(map[string]values.Value{"r": r}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			hour, _ = v.Object().Get("hour")
+			day, _ = v.Object().Get("day")
+			check(hour, day)
+		})
+	}
+}
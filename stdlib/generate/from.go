@@ -89,13 +89,20 @@ func (s *FromGeneratorOpSpec) Kind() flux.OperationKind {
 }
 
 type FromGeneratorProcedureSpec struct {
-	plan.DefaultCost
 	Start time.Time
 	Stop  time.Time
 	Count int64
 	Fn    compiler.Func
 }
 
+// Cost reports that the generator produces a single table of Count rows.
+func (s *FromGeneratorProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return plan.Cost{CPU: s.Count}, plan.Statistics{
+		Cardinality:      s.Count,
+		GroupCardinality: 1,
+	}
+}
+
 func newFromGeneratorProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	// TODO: copy over data from the OpSpec to the ProcedureSpec
 	spec, ok := qs.(*FromGeneratorOpSpec)
@@ -121,7 +128,7 @@ func (s *FromGeneratorProcedureSpec) Kind() plan.ProcedureKind {
 
 func (s *FromGeneratorProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromGeneratorProcedureSpec)
-
+	*ns = *s
 	return ns
 }
 
@@ -131,7 +131,7 @@ func (o *ToHTTPOpSpec) ReadArgs(args flux.Arguments) error {
 	if !ok {
 		o.Timeout = DefaultToHTTPTimeout
 	} else {
-		o.Timeout = time.Duration(timeout)
+		o.Timeout = timeout.Duration()
 	}
 
 	o.TimeColumn, ok, err = args.GetString("timeColumn")
@@ -48,9 +48,19 @@ func (s *FromOpSpec) Kind() flux.OperationKind {
 	return FromKind
 }
 
+// FromProcedureSpec is the procedure spec of from.
+// It records the projection and limit pushed down into it, which implementations
+// of from are expected to apply to the data they read. Implementations with their own
+// procedure spec implement plan.ProjectionSource and plan.LimitSource to receive them.
 type FromProcedureSpec struct {
 	plan.DefaultCost
 	Bucket string
+
+	// Projection is pushed down from keep or drop.
+	Projection *plan.Projection
+	// Limit and Offset are pushed down from limit.
+	Limit  int64
+	Offset int64
 }
 
 func newFromProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -71,5 +81,31 @@ func (s *FromProcedureSpec) Kind() plan.ProcedureKind {
 func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromProcedureSpec)
 	*ns = *s
+	if s.Projection != nil {
+		ns.Projection = &plan.Projection{
+			Columns: make([]string, len(s.Projection.Columns)),
+			Drop:    s.Projection.Drop,
+		}
+		copy(ns.Projection.Columns, s.Projection.Columns)
+	}
 	return ns
 }
+
+func (s *FromProcedureSpec) PushDownProjection(p plan.Projection) (plan.ProcedureSpec, bool) {
+	if s.Projection != nil {
+		return nil, false
+	}
+	ns := s.Copy().(*FromProcedureSpec)
+	ns.Projection = &p
+	return ns, true
+}
+
+func (s *FromProcedureSpec) PushDownLimit(n, offset int64) (plan.ProcedureSpec, bool) {
+	if s.Limit > 0 {
+		return nil, false
+	}
+	ns := s.Copy().(*FromProcedureSpec)
+	ns.Limit = n
+	ns.Offset = offset
+	return ns, true
+}
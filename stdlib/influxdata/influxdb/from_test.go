@@ -47,11 +47,11 @@ func TestFrom_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -4 * time.Hour,
+								Relative:   flux.ConvertDuration(-4 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
-								Relative:   -2 * time.Hour,
+								Relative:   flux.ConvertDuration(-2 * time.Hour),
 								IsRelative: true,
 							},
 							TimeColumn:  "_time",
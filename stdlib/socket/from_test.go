@@ -52,11 +52,11 @@ socket.from(url: "url", decoder: "line") |> range(start:-4h, stop:-2h) |> sum()`
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -4 * time.Hour,
+								Relative:   flux.ConvertDuration(-4 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
-								Relative:   -2 * time.Hour,
+								Relative:   flux.ConvertDuration(-2 * time.Hour),
 								IsRelative: true,
 							},
 							TimeColumn:  "_time",
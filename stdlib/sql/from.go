@@ -38,6 +38,7 @@ func init() {
 	flux.RegisterOpSpec(FromSQLKind, newFromSQLOp)
 	plan.RegisterProcedureSpec(FromSQLKind, newFromSQLProcedure, FromSQLKind)
 	execute.RegisterSource(FromSQLKind, createFromSQLSource)
+	plan.RegisterPhysicalRules(PushDownFilterRule{})
 }
 
 func createFromSQLOpSpec(args flux.Arguments, administration *flux.Administration) (flux.OperationSpec, error) {
@@ -77,6 +78,19 @@ type FromSQLProcedureSpec struct {
 	DriverName     string
 	DataSourceName string
 	Query          string
+
+	// Where are the predicates pushed down from a filter,
+	// which the rows of the query must all satisfy.
+	Where []*Predicate
+
+	// Columns are the columns pushed down from keep.
+	// All of the columns of the query are read if it is nil.
+	Columns []string
+
+	// Limit and Offset are pushed down from limit.
+	// All of the rows of the query are read if Limit is zero.
+	Limit  int64
+	Offset int64
 }
 
 func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -101,9 +115,46 @@ func (s *FromSQLProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.DriverName = s.DriverName
 	ns.DataSourceName = s.DataSourceName
 	ns.Query = s.Query
+	if len(s.Where) > 0 {
+		ns.Where = make([]*Predicate, len(s.Where))
+		copy(ns.Where, s.Where)
+	}
+	if s.Columns != nil {
+		ns.Columns = make([]string, len(s.Columns))
+		copy(ns.Columns, s.Columns)
+	}
+	ns.Limit = s.Limit
+	ns.Offset = s.Offset
 	return ns
 }
 
+// PushDownProjection selects only the columns kept by the projection.
+// The columns are selected in the order of the query when it runs.
+// The query cannot select all but some of its columns, so projections that drop columns
+// are not pushed down. Only one projection is pushed down.
+func (s *FromSQLProcedureSpec) PushDownProjection(p plan.Projection) (plan.ProcedureSpec, bool) {
+	if p.Drop || len(p.Columns) == 0 || s.Columns != nil {
+		return nil, false
+	}
+	ns := s.Copy().(*FromSQLProcedureSpec)
+	ns.Columns = make([]string, len(p.Columns))
+	copy(ns.Columns, p.Columns)
+	return ns, true
+}
+
+// PushDownLimit limits the rows of the query.
+// The query produces a single table, so the limit applies to all of its rows.
+// Only one limit is pushed down.
+func (s *FromSQLProcedureSpec) PushDownLimit(n, offset int64) (plan.ProcedureSpec, bool) {
+	if s.Limit > 0 {
+		return nil, false
+	}
+	ns := s.Copy().(*FromSQLProcedureSpec)
+	ns.Limit = n
+	ns.Offset = offset
+	return ns, true
+}
+
 func createFromSQLSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := prSpec.(*FromSQLProcedureSpec)
 	if !ok {
@@ -141,7 +192,18 @@ func (c *SQLIterator) Connect() error {
 }
 
 func (c *SQLIterator) Fetch() (bool, error) {
-	rows, err := c.db.Query(c.spec.Query)
+	spec := c.spec
+	if spec.Columns != nil {
+		// The kept columns are selected in the order of the query, not the order of the projection.
+		columns, err := c.queryColumns()
+		if err != nil {
+			return false, err
+		}
+		spec = spec.Copy().(*FromSQLProcedureSpec)
+		spec.Columns = orderColumns(spec.Columns, columns)
+	}
+	query, args := buildQuery(spec)
+	rows, err := c.db.Query(query, args...)
 	if err != nil {
 		return false, err
 	}
@@ -150,6 +212,16 @@ func (c *SQLIterator) Fetch() (bool, error) {
 	return false, nil
 }
 
+// queryColumns returns the names of the columns selected by the query of the spec.
+func (c *SQLIterator) queryColumns() ([]string, error) {
+	rows, err := c.db.Query(buildColumnsQuery(c.spec))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return rows.Columns()
+}
+
 func (c *SQLIterator) Decode() (flux.Table, error) {
 	groupKey := execute.NewGroupKey(nil, nil)
 	builder := execute.NewColListTableBuilder(groupKey, c.administration.Allocator())
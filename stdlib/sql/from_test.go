@@ -0,0 +1,261 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/sql"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestPushDownFilterRule(t *testing.T) {
+	member := func(name string) *semantic.MemberExpression {
+		return &semantic.MemberExpression{
+			Object:   &semantic.IdentifierExpression{Name: "r"},
+			Property: name,
+		}
+	}
+	filter := func(body semantic.Expression) *universe.FilterProcedureSpec {
+		return &universe.FilterProcedureSpec{
+			Fn: &semantic.FunctionExpression{
+				Block: &semantic.FunctionBlock{
+					Parameters: &semantic.FunctionParameters{
+						List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+					},
+					Body: body,
+				},
+			},
+		}
+	}
+	from := func(where ...*sql.Predicate) *sql.FromSQLProcedureSpec {
+		return &sql.FromSQLProcedureSpec{
+			DriverName:     "postgres",
+			DataSourceName: "postgresql://localhost",
+			Query:          "SELECT * FROM reports",
+			Where:          where,
+		}
+	}
+
+	var (
+		nameEqual = &semantic.BinaryExpression{
+			Operator: ast.EqualOperator,
+			Left:     member("name"),
+			Right:    &semantic.StringLiteral{Value: "cpu"},
+		}
+		valueGreater = &semantic.BinaryExpression{
+			Operator: ast.LessThanOperator,
+			Left:     &semantic.FloatLiteral{Value: 2.5},
+			Right:    member("value"),
+		}
+		// A comparison of two columns is not pushed down.
+		nameHost = &semantic.BinaryExpression{
+			Operator: ast.EqualOperator,
+			Left:     member("name"),
+			Right:    member("host"),
+		}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "comparison",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from()),
+					plan.CreatePhysicalNode("filter", filter(nameEqual)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(&sql.Predicate{Operator: "=", Column: "name", Value: "cpu"})),
+				},
+			},
+		},
+		{
+			Name:  "and or",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from()),
+					plan.CreatePhysicalNode("filter", filter(&semantic.LogicalExpression{
+						Operator: ast.AndOperator,
+						Left:     nameEqual,
+						Right: &semantic.LogicalExpression{
+							Operator: ast.OrOperator,
+							Left:     valueGreater,
+							Right:    nameEqual,
+						},
+					})),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(
+						&sql.Predicate{Operator: "=", Column: "name", Value: "cpu"},
+						&sql.Predicate{
+							Operator: "OR",
+							Left:     &sql.Predicate{Operator: ">", Column: "value", Value: 2.5},
+							Right:    &sql.Predicate{Operator: "=", Column: "name", Value: "cpu"},
+						},
+					)),
+				},
+			},
+		},
+		{
+			Name:  "partial",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from()),
+					plan.CreatePhysicalNode("filter", filter(&semantic.LogicalExpression{
+						Operator: ast.AndOperator,
+						Left:     nameHost,
+						Right:    nameEqual,
+					})),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(&sql.Predicate{Operator: "=", Column: "name", Value: "cpu"})),
+					plan.CreatePhysicalNode("filter", filter(nameHost)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "untranslatable",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from()),
+					plan.CreatePhysicalNode("filter", filter(&semantic.LogicalExpression{
+						Operator: ast.OrOperator,
+						Left:     nameHost,
+						Right:    nameEqual,
+					})),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "limited rows",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &sql.FromSQLProcedureSpec{
+						DriverName:     "postgres",
+						DataSourceName: "postgresql://localhost",
+						Query:          "SELECT * FROM reports",
+						Limit:          10,
+					}),
+					plan.CreatePhysicalNode("filter", filter(nameEqual)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "column not selected",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &sql.FromSQLProcedureSpec{
+						DriverName:     "postgres",
+						DataSourceName: "postgresql://localhost",
+						Query:          "SELECT * FROM reports",
+						Columns:        []string{"value"},
+					}),
+					plan.CreatePhysicalNode("filter", filter(nameEqual)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "rows used twice",
+			Rules: []plan.Rule{sql.PushDownFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from()),
+					plan.CreatePhysicalNode("filter", filter(nameEqual)),
+					plan.CreatePhysicalNode("count", &universe.CountProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
+
+func TestFromSQL_PushDown(t *testing.T) {
+	from := &sql.FromSQLProcedureSpec{
+		DriverName:     "postgres",
+		DataSourceName: "postgresql://localhost",
+		Query:          "SELECT * FROM reports",
+	}
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "keep and limit",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}, universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("keep", &universe.SchemaMutationProcedureSpec{
+						Mutations: []universe.SchemaMutation{&universe.KeepOpSpec{Columns: []string{"name"}}},
+					}),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &sql.FromSQLProcedureSpec{
+						DriverName:     "postgres",
+						DataSourceName: "postgresql://localhost",
+						Query:          "SELECT * FROM reports",
+						Columns:        []string{"name"},
+						Limit:          10,
+					}),
+				},
+			},
+		},
+		{
+			Name:  "drop",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("drop", &universe.SchemaMutationProcedureSpec{
+						Mutations: []universe.SchemaMutation{&universe.DropOpSpec{Columns: []string{"name"}}},
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
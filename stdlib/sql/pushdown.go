@@ -0,0 +1,330 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+// Predicate is a condition on the rows read by a SQL query.
+// It is either a comparison of a column to a value or
+// the AND or OR of two other predicates.
+type Predicate struct {
+	// Operator is a SQL comparison operator, AND or OR.
+	Operator string
+
+	// Column and Value are the operands of a comparison.
+	Column string
+	Value  interface{}
+
+	// Left and Right are the operands of AND and OR.
+	Left, Right *Predicate
+}
+
+var comparisonOperators = map[ast.OperatorKind]string{
+	ast.EqualOperator:            "=",
+	ast.NotEqualOperator:         "<>",
+	ast.LessThanOperator:         "<",
+	ast.LessThanEqualOperator:    "<=",
+	ast.GreaterThanOperator:      ">",
+	ast.GreaterThanEqualOperator: ">=",
+}
+
+// flippedOperators are the operators that compare the same values
+// when the operands of a comparison are swapped.
+var flippedOperators = map[string]string{
+	"=":  "=",
+	"<>": "<>",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// PushDownFilterRule pushes the conditions of a filter on the rows of sql.from into the
+// WHERE clause of the query, so that the rows are filtered by the database.
+// The conditions that cannot be translated to SQL remain in the filter.
+//
+//	filter
+//	  |      ==>   from (WHERE ...)
+//	from
+type PushDownFilterRule struct{}
+
+func (PushDownFilterRule) Name() string {
+	return "PushDownFilterRule"
+}
+
+func (PushDownFilterRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.FilterKind, plan.Pat(FromSQLKind))
+}
+
+func (PushDownFilterRule) Rewrite(filterNode plan.Node) (plan.Node, bool, error) {
+	fromNode := filterNode.Predecessors()[0]
+	if len(fromNode.Successors()) != 1 {
+		// The unfiltered rows are used by other nodes.
+		return filterNode, false, nil
+	}
+	fromSpec := fromNode.ProcedureSpec().(*FromSQLProcedureSpec)
+	if fromSpec.Limit > 0 {
+		// The rows are limited before they are filtered.
+		return filterNode, false, nil
+	}
+	filterSpec := filterNode.ProcedureSpec().(*universe.FilterProcedureSpec)
+	fn := filterSpec.Fn
+	if fn == nil || fn.Block == nil || fn.Block.Parameters == nil || len(fn.Block.Parameters.List) != 1 {
+		return filterNode, false, nil
+	}
+	body, ok := fn.Block.Body.(semantic.Expression)
+	if !ok {
+		return filterNode, false, nil
+	}
+	param := fn.Block.Parameters.List[0].Key.Name
+
+	var (
+		where    []*Predicate
+		residual semantic.Expression
+	)
+	for _, e := range conjuncts(body) {
+		if p, ok := translatePredicate(e, param); ok && fromSpec.selects(p) {
+			where = append(where, p)
+		} else if residual == nil {
+			residual = e
+		} else {
+			residual = &semantic.LogicalExpression{
+				Operator: ast.AndOperator,
+				Left:     residual,
+				Right:    e,
+			}
+		}
+	}
+	if len(where) == 0 {
+		return filterNode, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*FromSQLProcedureSpec)
+	newFromSpec.Where = append(newFromSpec.Where, where...)
+	if err := fromNode.ReplaceSpec(newFromSpec); err != nil {
+		return nil, false, err
+	}
+	if residual == nil {
+		return fromNode, true, nil
+	}
+
+	newFilterSpec := filterSpec.Copy().(*universe.FilterProcedureSpec)
+	newFilterSpec.Fn.Block.Body = residual
+	if err := filterNode.ReplaceSpec(newFilterSpec); err != nil {
+		return nil, false, err
+	}
+	return filterNode, true, nil
+}
+
+// selects reports whether the columns of the predicate are selected by the query.
+// A predicate on a column that is not selected is left to the filter,
+// so that the filter fails the same way as it does without the pushdown.
+func (s *FromSQLProcedureSpec) selects(p *Predicate) bool {
+	if p.Left != nil {
+		return s.selects(p.Left) && s.selects(p.Right)
+	}
+	if s.Columns == nil {
+		return true
+	}
+	for _, c := range s.Columns {
+		if c == p.Column {
+			return true
+		}
+	}
+	return false
+}
+
+// conjuncts splits the expression into the expressions that are joined with and.
+func conjuncts(e semantic.Expression) []semantic.Expression {
+	if l, ok := e.(*semantic.LogicalExpression); ok && l.Operator == ast.AndOperator {
+		return append(conjuncts(l.Left), conjuncts(l.Right)...)
+	}
+	return []semantic.Expression{e}
+}
+
+// translatePredicate translates a comparison of a column of the row param to a literal,
+// or the and or or of such comparisons, into a predicate.
+func translatePredicate(e semantic.Expression, param string) (*Predicate, bool) {
+	switch e := e.(type) {
+	case *semantic.LogicalExpression:
+		left, ok := translatePredicate(e.Left, param)
+		if !ok {
+			return nil, false
+		}
+		right, ok := translatePredicate(e.Right, param)
+		if !ok {
+			return nil, false
+		}
+		op := "AND"
+		if e.Operator == ast.OrOperator {
+			op = "OR"
+		}
+		return &Predicate{Operator: op, Left: left, Right: right}, true
+	case *semantic.BinaryExpression:
+		op, ok := comparisonOperators[e.Operator]
+		if !ok {
+			return nil, false
+		}
+		if col, ok := column(e.Left, param); ok {
+			if v, ok := literal(e.Right); ok {
+				return &Predicate{Operator: op, Column: col, Value: v}, true
+			}
+		}
+		if col, ok := column(e.Right, param); ok {
+			if v, ok := literal(e.Left); ok {
+				return &Predicate{Operator: flippedOperators[op], Column: col, Value: v}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// column returns the name of the column if the expression is a member of the row param.
+func column(e semantic.Expression, param string) (string, bool) {
+	m, ok := e.(*semantic.MemberExpression)
+	if !ok {
+		return "", false
+	}
+	if id, ok := m.Object.(*semantic.IdentifierExpression); !ok || id.Name != param {
+		return "", false
+	}
+	return m.Property, true
+}
+
+// literal returns the value of the expression if it is a literal that can be passed to the database.
+func literal(e semantic.Expression) (interface{}, bool) {
+	switch e := e.(type) {
+	case *semantic.StringLiteral:
+		return e.Value, true
+	case *semantic.IntegerLiteral:
+		return e.Value, true
+	case *semantic.FloatLiteral:
+		return e.Value, true
+	case *semantic.BooleanLiteral:
+		return e.Value, true
+	case *semantic.DateTimeLiteral:
+		return e.Value, true
+	default:
+		return nil, false
+	}
+}
+
+// queryBuilder writes a query with the predicates pushed down into it,
+// passing the values of the comparisons as arguments of the query.
+type queryBuilder struct {
+	driverName string
+	sb         strings.Builder
+	args       []interface{}
+}
+
+func (b *queryBuilder) write(p *Predicate) {
+	switch p.Operator {
+	case "AND", "OR":
+		b.sb.WriteString("(")
+		b.write(p.Left)
+		b.sb.WriteString(" " + p.Operator + " ")
+		b.write(p.Right)
+		b.sb.WriteString(")")
+	default:
+		b.args = append(b.args, p.Value)
+		col := b.quoteIdentifier(p.Column)
+		if _, ok := p.Value.(string); ok {
+			switch {
+			case b.driverName == "mysql":
+				// MySQL compares strings without regard to case by default.
+				col = "BINARY " + col
+			case b.driverName == "postgres" && p.Operator != "=" && p.Operator != "<>":
+				// Postgres orders strings by the collation of the database,
+				// while flux orders them byte by byte.
+				col += ` COLLATE "C"`
+			}
+		}
+		fmt.Fprintf(&b.sb, "%s %s %s", col, p.Operator, b.placeholder(len(b.args)))
+	}
+}
+
+func (b *queryBuilder) quoteIdentifier(name string) string {
+	if b.driverName == "mysql" {
+		return "`" + strings.Replace(name, "`", "``", -1) + "`"
+	}
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func (b *queryBuilder) placeholder(n int) string {
+	if b.driverName == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// buildQuery returns the query of the spec with the columns, predicates and limit
+// pushed down into it and the arguments of the query. The original query is wrapped
+// in a subquery, so the pushed down clauses apply to the columns it selects.
+func buildQuery(spec *FromSQLProcedureSpec) (string, []interface{}) {
+	if len(spec.Where) == 0 && spec.Columns == nil && spec.Limit == 0 {
+		return spec.Query, nil
+	}
+	b := &queryBuilder{driverName: spec.DriverName}
+	b.sb.WriteString("SELECT ")
+	if spec.Columns == nil {
+		b.sb.WriteString("*")
+	}
+	for i, c := range spec.Columns {
+		if i > 0 {
+			b.sb.WriteString(", ")
+		}
+		b.sb.WriteString(b.quoteIdentifier(c))
+	}
+	fmt.Fprintf(&b.sb, " FROM (%s) AS flux_from", subquery(spec))
+	for i, p := range spec.Where {
+		if i == 0 {
+			b.sb.WriteString(" WHERE ")
+		} else {
+			b.sb.WriteString(" AND ")
+		}
+		b.write(p)
+	}
+	if spec.Limit > 0 {
+		fmt.Fprintf(&b.sb, " LIMIT %d", spec.Limit)
+		if spec.Offset > 0 {
+			fmt.Fprintf(&b.sb, " OFFSET %d", spec.Offset)
+		}
+	}
+	return b.sb.String(), b.args
+}
+
+// subquery returns the query of the spec so that it can be wrapped by another query.
+func subquery(spec *FromSQLProcedureSpec) string {
+	return strings.TrimRight(strings.TrimSpace(spec.Query), ";")
+}
+
+// buildColumnsQuery returns a query that selects no rows,
+// so that the columns of the query of the spec can be read without reading its data.
+func buildColumnsQuery(spec *FromSQLProcedureSpec) string {
+	return fmt.Sprintf("SELECT * FROM (%s) AS flux_from WHERE 1 = 0", subquery(spec))
+}
+
+// orderColumns returns the kept columns in the order the query selects them,
+// which is the order that keep preserves. Kept columns that the query does
+// not select are left out, unless none of them are selected.
+func orderColumns(kept, queryColumns []string) []string {
+	ordered := make([]string, 0, len(kept))
+	for _, c := range queryColumns {
+		for _, k := range kept {
+			if c == k {
+				ordered = append(ordered, c)
+				break
+			}
+		}
+	}
+	if len(ordered) == 0 {
+		return kept
+	}
+	return ordered
+}
@@ -0,0 +1,147 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildQuery(t *testing.T) {
+	where := []*Predicate{
+		{Operator: "=", Column: "name", Value: "cpu"},
+		{
+			Operator: "OR",
+			Left:     &Predicate{Operator: ">", Column: "value", Value: 2.5},
+			Right:    &Predicate{Operator: "<=", Column: `weird"col`, Value: int64(3)},
+		},
+	}
+	testcases := []struct {
+		name      string
+		spec      *FromSQLProcedureSpec
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name: "no predicates",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM reports;",
+			},
+			wantQuery: "SELECT * FROM reports;",
+		},
+		{
+			name: "postgres",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM reports;",
+				Where:      where,
+			},
+			wantQuery: `SELECT * FROM (SELECT * FROM reports) AS flux_from WHERE "name" = $1 AND ("value" > $2 OR "weird""col" <= $3)`,
+			wantArgs:  []interface{}{"cpu", 2.5, int64(3)},
+		},
+		{
+			name: "postgres string ordering",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM reports",
+				Where: []*Predicate{
+					{Operator: ">=", Column: "name", Value: "a"},
+					{Operator: "<>", Column: "name", Value: "cpu"},
+				},
+			},
+			wantQuery: `SELECT * FROM (SELECT * FROM reports) AS flux_from WHERE "name" COLLATE "C" >= $1 AND "name" <> $2`,
+			wantArgs:  []interface{}{"a", "cpu"},
+		},
+		{
+			name: "mysql",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "mysql",
+				Query:      "SELECT * FROM reports",
+				Where:      where,
+			},
+			wantQuery: "SELECT * FROM (SELECT * FROM reports) AS flux_from WHERE BINARY `name` = ? AND (`value` > ? OR `weird\"col` <= ?)",
+			wantArgs:  []interface{}{"cpu", 2.5, int64(3)},
+		},
+		{
+			name: "columns and limit",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM reports",
+				Where:      where[:1],
+				Columns:    []string{"name", "value"},
+				Limit:      10,
+				Offset:     20,
+			},
+			wantQuery: `SELECT "name", "value" FROM (SELECT * FROM reports) AS flux_from WHERE "name" = $1 LIMIT 10 OFFSET 20`,
+			wantArgs:  []interface{}{"cpu"},
+		},
+		{
+			name: "limit",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "mysql",
+				Query:      "SELECT * FROM reports",
+				Limit:      10,
+			},
+			wantQuery: "SELECT * FROM (SELECT * FROM reports) AS flux_from LIMIT 10",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			query, args := buildQuery(tc.spec)
+			if query != tc.wantQuery {
+				t.Errorf("unexpected query -want/+got:\n\t- %s\n\t+ %s", tc.wantQuery, query)
+			}
+			if !cmp.Equal(tc.wantArgs, args) {
+				t.Errorf("unexpected arguments -want/+got\n%s", cmp.Diff(tc.wantArgs, args))
+			}
+		})
+	}
+}
+
+func TestBuildColumnsQuery(t *testing.T) {
+	spec := &FromSQLProcedureSpec{
+		DriverName: "postgres",
+		Query:      "SELECT name, value FROM reports;",
+	}
+	want := "SELECT * FROM (SELECT name, value FROM reports) AS flux_from WHERE 1 = 0"
+	if got := buildColumnsQuery(spec); got != want {
+		t.Errorf("unexpected query -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
+
+func TestOrderColumns(t *testing.T) {
+	testcases := []struct {
+		name         string
+		kept         []string
+		queryColumns []string
+		want         []string
+	}{
+		{
+			name:         "query order",
+			kept:         []string{"value", "_time", "name"},
+			queryColumns: []string{"_time", "name", "host", "value"},
+			want:         []string{"_time", "name", "value"},
+		},
+		{
+			name:         "missing column",
+			kept:         []string{"value", "missing"},
+			queryColumns: []string{"name", "value"},
+			want:         []string{"value"},
+		},
+		{
+			name:         "no selected column",
+			kept:         []string{"missing"},
+			queryColumns: []string{"name", "value"},
+			want:         []string{"missing"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := orderColumns(tc.kept, tc.queryColumns); !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected columns -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
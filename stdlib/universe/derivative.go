@@ -49,10 +49,13 @@ func createDerivativeOpSpec(args flux.Arguments, a *flux.Administration) (flux.O
 	if unit, ok, err := args.GetDuration("unit"); err != nil {
 		return nil, err
 	} else if ok {
+		if unit.Months() != 0 {
+			return nil, fmt.Errorf("derivative unit cannot have a month component: %v", unit)
+		}
 		spec.Unit = unit
 	} else {
 		//Default is 1s
-		spec.Unit = flux.Duration(time.Second)
+		spec.Unit = flux.ConvertDuration(time.Second)
 	}
 
 	if nn, ok, err := args.GetBool("nonNegative"); err != nil {
@@ -155,7 +158,7 @@ func NewDerivativeTransformation(d execute.Dataset, cache execute.TableBuilderCa
 	return &derivativeTransformation{
 		d:           d,
 		cache:       cache,
-		unit:        float64(spec.Unit),
+		unit:        float64(spec.Unit.Duration()),
 		nonNegative: spec.NonNegative,
 		columns:     spec.Columns,
 		timeCol:     spec.TimeColumn,
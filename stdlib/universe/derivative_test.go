@@ -17,13 +17,35 @@ func TestDerivativeOperation_Marshaling(t *testing.T) {
 	op := &flux.Operation{
 		ID: "derivative",
 		Spec: &universe.DerivativeOpSpec{
-			Unit:        flux.Duration(time.Minute),
+			Unit:        flux.ConvertDuration(time.Minute),
 			NonNegative: true,
 		},
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestDerivative_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "unit with months",
+			Raw:     `from(bucket:"mydb") |> range(start:-1h) |> derivative(unit: 1mo)`,
+			WantErr: true,
+		},
+		{
+			Name:    "unit with years",
+			Raw:     `from(bucket:"mydb") |> range(start:-1h) |> derivative(unit: 1y)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestDerivative_PassThrough(t *testing.T) {
 	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 		s := universe.NewDerivativeTransformation(
@@ -48,7 +70,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -75,7 +97,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       flux.Duration(time.Second),
+				Unit:       flux.ConvertDuration(time.Second),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -102,7 +124,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -129,7 +151,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       flux.Duration(time.Second),
+				Unit:       flux.ConvertDuration(time.Second),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -156,7 +178,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:     []string{execute.DefaultValueColLabel},
 				TimeColumn:  execute.DefaultTimeColLabel,
-				Unit:        1,
+				Unit:        flux.ConvertDuration(1),
 				NonNegative: true,
 			},
 			data: []flux.Table{&executetest.Table{
@@ -186,7 +208,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -213,7 +235,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -240,7 +262,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:     []string{execute.DefaultValueColLabel},
 				TimeColumn:  execute.DefaultTimeColLabel,
-				Unit:        1,
+				Unit:        flux.ConvertDuration(1),
 				NonNegative: true,
 			},
 			data: []flux.Table{&executetest.Table{
@@ -270,7 +292,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       flux.Duration(time.Second),
+				Unit:       flux.ConvertDuration(time.Second),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -297,7 +319,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:     []string{execute.DefaultValueColLabel},
 				TimeColumn:  execute.DefaultTimeColLabel,
-				Unit:        1,
+				Unit:        flux.ConvertDuration(1),
 				NonNegative: true,
 			},
 			data: []flux.Table{&executetest.Table{
@@ -327,7 +349,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{execute.DefaultValueColLabel},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -356,7 +378,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{"x", "y"},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -385,7 +407,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:     []string{"x", "y"},
 				TimeColumn:  execute.DefaultTimeColLabel,
-				Unit:        1,
+				Unit:        flux.ConvertDuration(1),
 				NonNegative: true,
 			},
 			data: []flux.Table{&executetest.Table{
@@ -417,7 +439,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{"x", "y"},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -448,7 +470,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{"x", "y"},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -473,7 +495,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{"x", "y"},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
@@ -498,7 +520,7 @@ func TestDerivative_Process(t *testing.T) {
 			spec: &universe.DerivativeProcedureSpec{
 				Columns:    []string{"x"},
 				TimeColumn: execute.DefaultTimeColLabel,
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
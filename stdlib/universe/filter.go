@@ -67,10 +67,22 @@ func (s *FilterOpSpec) Kind() flux.OperationKind {
 }
 
 type FilterProcedureSpec struct {
-	plan.DefaultCost
 	Fn *semantic.FunctionExpression
 }
 
+// filterSelectivityDivisor estimates that a filter keeps one row
+// out of every filterSelectivityDivisor rows of its input.
+const filterSelectivityDivisor = 3
+
+// Cost estimates that the filter keeps a third of the rows of each table.
+func (s *FilterProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality}, plan.Statistics{
+		Cardinality:      in.Cardinality / filterSelectivityDivisor,
+		GroupCardinality: in.GroupCardinality,
+	}
+}
+
 func newFilterProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	spec, ok := qs.(*FilterOpSpec)
 	if !ok {
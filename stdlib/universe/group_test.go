@@ -45,7 +45,7 @@ func TestGroup_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Minute,
+								Relative:   flux.ConvertDuration(-1 * time.Minute),
 								IsRelative: true,
 							},
 							Stop:        flux.Time{IsRelative: true},
@@ -78,7 +78,7 @@ func TestGroup_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Minute,
+								Relative:   flux.ConvertDuration(-1 * time.Minute),
 								IsRelative: true,
 							},
 							Stop:        flux.Time{IsRelative: true},
@@ -111,7 +111,7 @@ func TestGroup_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Minute,
+								Relative:   flux.ConvertDuration(-1 * time.Minute),
 								IsRelative: true,
 							},
 							Stop:        flux.Time{IsRelative: true},
@@ -144,7 +144,7 @@ func TestGroup_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Minute,
+								Relative:   flux.ConvertDuration(-1 * time.Minute),
 								IsRelative: true,
 							},
 							Stop:        flux.Time{IsRelative: true},
@@ -180,7 +180,7 @@ func TestGroup_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Minute,
+								Relative:   flux.ConvertDuration(-1 * time.Minute),
 								IsRelative: true,
 							},
 							Stop:        flux.Time{IsRelative: true},
@@ -44,10 +44,13 @@ func createIntegralOpSpec(args flux.Arguments, a *flux.Administration) (flux.Ope
 	if unit, ok, err := args.GetDuration("unit"); err != nil {
 		return nil, err
 	} else if ok {
+		if unit.Months() != 0 {
+			return nil, fmt.Errorf("integral unit cannot have a month component: %v", unit)
+		}
 		spec.Unit = unit
 	} else {
 		//Default is 1s
-		spec.Unit = flux.Duration(time.Second)
+		spec.Unit = flux.ConvertDuration(time.Second)
 	}
 
 	if timeValue, ok, err := args.GetString("timeColumn"); err != nil {
@@ -168,7 +171,7 @@ func (t *integralTransformation) Process(id execute.DatasetID, tbl flux.Table) e
 			return fmt.Errorf("cannot perform integral over %v", typ)
 		}
 
-		integrals[idx] = newIntegral(time.Duration(t.spec.Unit))
+		integrals[idx] = newIntegral(t.spec.Unit.Duration())
 		newIdx, err := builder.AddCol(flux.ColMeta{
 			Label: c,
 			Type:  flux.TFloat,
@@ -17,12 +17,34 @@ func TestIntegralOperation_Marshaling(t *testing.T) {
 	op := &flux.Operation{
 		ID: "integral",
 		Spec: &universe.IntegralOpSpec{
-			Unit: flux.Duration(time.Minute),
+			Unit: flux.ConvertDuration(time.Minute),
 		},
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestIntegral_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "unit with months",
+			Raw:     `from(bucket:"mydb") |> range(start:-1h) |> integral(unit: 1mo)`,
+			WantErr: true,
+		},
+		{
+			Name:    "unit with years",
+			Raw:     `from(bucket:"mydb") |> range(start:-1h) |> integral(unit: 1y)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestIntegral_PassThrough(t *testing.T) {
 	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 		s := universe.NewIntegralTransformation(
@@ -45,7 +67,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -77,7 +99,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "int",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -109,7 +131,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "uint",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -141,7 +163,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with units",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            flux.Duration(time.Second),
+				Unit:            flux.ConvertDuration(time.Second),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -173,7 +195,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with tags",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -206,7 +228,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with multiple values",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 				TimeColumn: execute.DefaultTimeColLabel,
 				AggregateConfig: execute.AggregateConfig{
 					Columns: []string{"x", "y"},
@@ -244,7 +266,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with null timestamps",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -268,7 +290,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with null values",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -303,7 +325,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with out-of-order timestamps",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -327,7 +349,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "integral over string",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:       1,
+				Unit:       flux.ConvertDuration(1),
 				TimeColumn: execute.DefaultTimeColLabel,
 				AggregateConfig: execute.AggregateConfig{
 					Columns: []string{"t"},
@@ -352,7 +374,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float repeated times",
 			spec: &universe.IntegralProcedureSpec{
-				Unit:            1,
+				Unit:            flux.ConvertDuration(1),
 				TimeColumn:      execute.DefaultTimeColLabel,
 				AggregateConfig: execute.DefaultAggregateConfig,
 			},
@@ -0,0 +1,321 @@
+package universe
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const IntervalsKind = "intervals"
+
+var (
+	intervalType = semantic.NewObjectType(map[string]semantic.Type{
+		"start": semantic.Time,
+		"stop":  semantic.Time,
+	})
+	intervalPolyType = semantic.NewObjectPolyType(
+		map[string]semantic.PolyType{
+			"start": semantic.Time,
+			"stop":  semantic.Time,
+		},
+		semantic.LabelSet{"start", "stop"},
+		semantic.LabelSet{"start", "stop"},
+	)
+
+	// intervalsGeneratorPolyType is the type of the function returned by intervals.
+	// It accepts start and stop times and returns the set of intervals that intersect them.
+	intervalsGeneratorPolyType = semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"start": semantic.Time,
+			"stop":  semantic.Time,
+		},
+		Required: semantic.LabelSet{"start", "stop"},
+		Return:   semantic.NewArrayPolyType(intervalPolyType),
+	})
+)
+
+func init() {
+	intervalsPolyType := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"every":  semantic.Duration,
+			"period": semantic.Duration,
+			"offset": semantic.Duration,
+			"filter": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{
+					"interval": intervalPolyType,
+				},
+				Required: semantic.LabelSet{"interval"},
+				Return:   semantic.Bool,
+			}),
+			"location": flux.LocationPolyType,
+		},
+		Return: intervalsGeneratorPolyType,
+	})
+	intervals := values.NewFunction(IntervalsKind, intervalsPolyType, createIntervals, false)
+	// The location defaults to the location option of the caller.
+	flux.RegisterPackageValue("universe", IntervalsKind, interpreter.WithOptionDefaults(intervals, map[string]string{"location": "location"}))
+}
+
+func createIntervals(args values.Object) (values.Value, error) {
+	return interpreter.DoFunctionCall(func(args interpreter.Arguments) (values.Value, error) {
+		a := flux.Arguments{Arguments: args}
+		every, everySet, err := a.GetDuration("every")
+		if err != nil {
+			return nil, err
+		}
+		period, periodSet, err := a.GetDuration("period")
+		if err != nil {
+			return nil, err
+		}
+		offset, _, err := a.GetDuration("offset")
+		if err != nil {
+			return nil, err
+		}
+		filter, _, err := a.GetFunction("filter")
+		if err != nil {
+			return nil, err
+		}
+		var location *time.Location
+		if obj, ok, err := a.GetObject("location"); err != nil {
+			return nil, err
+		} else if ok {
+			l, err := flux.LocationFromValue(obj)
+			if err != nil {
+				return nil, err
+			}
+			if location, err = l.Load(); err != nil {
+				return nil, err
+			}
+		}
+
+		if !everySet && !periodSet {
+			return nil, errors.New(`intervals function requires at least one of "every" or "period" to be set`)
+		}
+		// Apply defaults
+		if !everySet {
+			every = period
+			if every.IsNegative() {
+				every = every.Neg()
+			}
+		}
+		if !periodSet {
+			period = every
+		}
+		if !every.IsPositive() {
+			return nil, errors.New("intervals every must be a positive duration")
+		}
+		if period.IsZero() {
+			return nil, errors.New("intervals period must not be zero")
+		}
+
+		g := &intervalsGenerator{
+			every:    every,
+			period:   period,
+			offset:   offset,
+			filter:   filter,
+			location: location,
+		}
+		return &intervalsFunction{
+			valueFunction: values.NewFunction(IntervalsKind, intervalsGeneratorPolyType, g.call, false),
+			g:             g,
+		}, nil
+	}, args)
+}
+
+// intervalsFunction is the function returned by intervals.
+// It keeps a reference to its generator so that window can
+// apply its own location to intervals that do not set one.
+type intervalsFunction struct {
+	valueFunction
+	g *intervalsGenerator
+}
+
+// valueFunction is embedded by intervalsFunction under a name
+// that does not hide the Function method of values.Value.
+type valueFunction = values.Function
+
+// intervalsGenerator produces the intervals that intersect a range of time.
+// Intervals are anchored at the Unix epoch in the local time of location, shifted by offset, and repeat every duration.
+// A positive period starts an interval at its anchor, a negative period ends an interval at its anchor.
+type intervalsGenerator struct {
+	every    execute.Duration
+	period   execute.Duration
+	offset   execute.Duration
+	filter   values.Function
+	location *time.Location
+}
+
+func (g *intervalsGenerator) call(args values.Object) (values.Value, error) {
+	return interpreter.DoFunctionCall(func(args interpreter.Arguments) (values.Value, error) {
+		start, err := args.GetRequired("start")
+		if err != nil {
+			return nil, err
+		}
+		if start.Type() != semantic.Time {
+			return nil, fmt.Errorf("keyword argument %q should be of kind %v, but got %v", "start", semantic.Time, start.Type().Nature())
+		}
+		stop, err := args.GetRequired("stop")
+		if err != nil {
+			return nil, err
+		}
+		if stop.Type() != semantic.Time {
+			return nil, fmt.Errorf("keyword argument %q should be of kind %v, but got %v", "stop", semantic.Time, stop.Type().Nature())
+		}
+
+		bounds, err := g.generate(execute.Bounds{
+			Start: start.Time(),
+			Stop:  stop.Time(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		elements := make([]values.Value, len(bounds))
+		for i, b := range bounds {
+			elements[i] = newIntervalValue(b)
+		}
+		return values.NewArrayWithBacking(intervalType, elements), nil
+	}, args)
+}
+
+// generate returns every interval that intersects the bounds and passes the filter.
+func (g *intervalsGenerator) generate(bounds execute.Bounds) ([]execute.Bounds, error) {
+	if bounds.IsEmpty() {
+		return nil, nil
+	}
+
+	// Calendar durations do not have a fixed length,
+	// so estimate the first interval and then search for the exact one.
+	n := floorDiv(int64(bounds.Start-g.epoch())-int64(g.offset.Duration()), int64(g.every.Duration()))
+	for g.interval(n).Stop > bounds.Start {
+		n--
+	}
+	for g.interval(n).Stop <= bounds.Start {
+		n++
+	}
+
+	var intervals []execute.Bounds
+	for ; ; n++ {
+		b := g.interval(n)
+		if b.Start >= bounds.Stop {
+			break
+		}
+		keep := true
+		if g.filter != nil {
+			v, err := g.filter.Call(values.NewObjectWithValues(map[string]values.Value{
+				"interval": newIntervalValue(b),
+			}))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to evaluate intervals filter")
+			}
+			if v.Type() != semantic.Bool {
+				return nil, fmt.Errorf("intervals filter must return a bool, got %v", v.Type())
+			}
+			keep = v.Bool()
+		}
+		if keep {
+			intervals = append(intervals, b)
+		}
+	}
+	return intervals, nil
+}
+
+// epoch returns the Unix epoch in the local time of the generator location.
+func (g *intervalsGenerator) epoch() execute.Time {
+	if g.location == nil {
+		return 0
+	}
+	return values.ConvertTime(time.Date(1970, time.January, 1, 0, 0, 0, 0, g.location))
+}
+
+// interval returns the nth interval from the epoch.
+// An interval starts at its anchor when the period is positive and stops at its anchor when the period is negative.
+func (g *intervalsGenerator) interval(n int64) execute.Bounds {
+	anchor := g.epoch().AddIn(g.every.Mul(n), g.location).AddIn(g.offset, g.location)
+	if g.period.IsNegative() {
+		return execute.Bounds{
+			Start: anchor.AddIn(g.period, g.location),
+			Stop:  anchor,
+		}
+	}
+	return execute.Bounds{
+		Start: anchor,
+		Stop:  anchor.AddIn(g.period, g.location),
+	}
+}
+
+func newIntervalValue(b execute.Bounds) values.Value {
+	return values.NewObjectWithValues(map[string]values.Value{
+		"start": values.NewTime(b.Start),
+		"stop":  values.NewTime(b.Stop),
+	})
+}
+
+// floorDiv divides n by d rounding towards negative infinity.
+func floorDiv(n, d int64) int64 {
+	q := n / d
+	if (n%d != 0) && ((n < 0) != (d < 0)) {
+		q--
+	}
+	return q
+}
+
+// callIntervals invokes an intervals generator over the bounds
+// and returns the resulting intervals sorted by their start time.
+// Generators created by intervals without a location use the given location.
+func callIntervals(fn values.Function, bounds execute.Bounds, location *time.Location) ([]execute.Bounds, error) {
+	if f, ok := fn.(*intervalsFunction); ok && f.g.location == nil {
+		g := *f.g
+		g.location = location
+		return g.generate(bounds)
+	}
+	v, err := fn.Call(values.NewObjectWithValues(map[string]values.Value{
+		"start": values.NewTime(bounds.Start),
+		"stop":  values.NewTime(bounds.Stop),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if v.Type().Nature() != semantic.Array {
+		return nil, fmt.Errorf("intervals must return an array of intervals, got %v", v.Type())
+	}
+	arr := v.Array()
+	intervals := make([]execute.Bounds, 0, arr.Len())
+	var rangeErr error
+	arr.Range(func(i int, v values.Value) {
+		if rangeErr != nil {
+			return
+		}
+		if v.Type().Nature() != semantic.Object {
+			rangeErr = fmt.Errorf("interval must be an object, got %v", v.Type())
+			return
+		}
+		start, startOK := v.Object().Get("start")
+		stop, stopOK := v.Object().Get("stop")
+		if !startOK || !stopOK || start.Type() != semantic.Time || stop.Type() != semantic.Time {
+			rangeErr = errors.New(`interval must have "start" and "stop" time properties`)
+			return
+		}
+		b := execute.Bounds{
+			Start: start.Time(),
+			Stop:  stop.Time(),
+		}
+		intervals = append(intervals, b)
+	})
+	if rangeErr != nil {
+		return nil, rangeErr
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		if intervals[i].Start == intervals[j].Start {
+			return intervals[i].Stop < intervals[j].Stop
+		}
+		return intervals[i].Start < intervals[j].Start
+	})
+	return intervals, nil
+}
@@ -0,0 +1,237 @@
+package universe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/values"
+)
+
+func TestIntervals(t *testing.T) {
+	mustParse := func(s string) execute.Time {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			panic(err)
+		}
+		return execute.Time(t.UnixNano())
+	}
+	testCases := []struct {
+		name    string
+		option  string
+		fn      string
+		start   string
+		stop    string
+		want    []execute.Bounds
+		wantErr bool
+	}{
+		{
+			name:  "every hour",
+			fn:    `intervals(every:1h)`,
+			start: "2018-09-05T00:00:00-07:00",
+			stop:  "2018-09-05T03:00:00-07:00",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-05T00:00:00-07:00"), Stop: mustParse("2018-09-05T01:00:00-07:00")},
+				{Start: mustParse("2018-09-05T01:00:00-07:00"), Stop: mustParse("2018-09-05T02:00:00-07:00")},
+				{Start: mustParse("2018-09-05T02:00:00-07:00"), Stop: mustParse("2018-09-05T03:00:00-07:00")},
+			},
+		},
+		{
+			name:  "unaligned range",
+			fn:    `intervals(every:1h)`,
+			start: "2018-09-05T00:30:00Z",
+			stop:  "2018-09-05T02:30:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-05T00:00:00Z"), Stop: mustParse("2018-09-05T01:00:00Z")},
+				{Start: mustParse("2018-09-05T01:00:00Z"), Stop: mustParse("2018-09-05T02:00:00Z")},
+				{Start: mustParse("2018-09-05T02:00:00Z"), Stop: mustParse("2018-09-05T03:00:00Z")},
+			},
+		},
+		{
+			name:  "overlapping period",
+			fn:    `intervals(every:1h, period:1h30m)`,
+			start: "2018-09-05T00:00:00Z",
+			stop:  "2018-09-05T02:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-04T23:00:00Z"), Stop: mustParse("2018-09-05T00:30:00Z")},
+				{Start: mustParse("2018-09-05T00:00:00Z"), Stop: mustParse("2018-09-05T01:30:00Z")},
+				{Start: mustParse("2018-09-05T01:00:00Z"), Stop: mustParse("2018-09-05T02:30:00Z")},
+			},
+		},
+		{
+			name:  "offset",
+			fn:    `intervals(every:1h, period:2h, offset:30m)`,
+			start: "2018-09-05T00:00:00Z",
+			stop:  "2018-09-05T01:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-04T22:30:00Z"), Stop: mustParse("2018-09-05T00:30:00Z")},
+				{Start: mustParse("2018-09-04T23:30:00Z"), Stop: mustParse("2018-09-05T01:30:00Z")},
+				{Start: mustParse("2018-09-05T00:30:00Z"), Stop: mustParse("2018-09-05T02:30:00Z")},
+			},
+		},
+		{
+			name:  "negative period",
+			fn:    `intervals(every:1d, period:-1h)`,
+			start: "2018-09-05T00:00:00Z",
+			stop:  "2018-09-07T00:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-05T23:00:00Z"), Stop: mustParse("2018-09-06T00:00:00Z")},
+				{Start: mustParse("2018-09-06T23:00:00Z"), Stop: mustParse("2018-09-07T00:00:00Z")},
+			},
+		},
+		{
+			name:  "period only",
+			fn:    `intervals(period:-1h)`,
+			start: "2018-09-05T00:00:00Z",
+			stop:  "2018-09-05T02:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-05T00:00:00Z"), Stop: mustParse("2018-09-05T01:00:00Z")},
+				{Start: mustParse("2018-09-05T01:00:00Z"), Stop: mustParse("2018-09-05T02:00:00Z")},
+			},
+		},
+		{
+			name:  "filter",
+			fn:    `intervals(every:1h, filter:(interval) => interval.start != 2018-09-05T01:00:00Z)`,
+			start: "2018-09-05T00:00:00Z",
+			stop:  "2018-09-05T03:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-09-05T00:00:00Z"), Stop: mustParse("2018-09-05T01:00:00Z")},
+				{Start: mustParse("2018-09-05T02:00:00Z"), Stop: mustParse("2018-09-05T03:00:00Z")},
+			},
+		},
+		{
+			name:  "every month",
+			fn:    `intervals(every:1mo)`,
+			start: "2018-01-01T00:00:00Z",
+			stop:  "2018-04-01T00:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-01-01T00:00:00Z"), Stop: mustParse("2018-02-01T00:00:00Z")},
+				{Start: mustParse("2018-02-01T00:00:00Z"), Stop: mustParse("2018-03-01T00:00:00Z")},
+				{Start: mustParse("2018-03-01T00:00:00Z"), Stop: mustParse("2018-04-01T00:00:00Z")},
+			},
+		},
+		{
+			name:  "last day of each month",
+			fn:    `intervals(every:1mo, period:-1d)`,
+			start: "2018-01-15T00:00:00Z",
+			stop:  "2018-03-15T00:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2018-01-31T00:00:00Z"), Stop: mustParse("2018-02-01T00:00:00Z")},
+				{Start: mustParse("2018-02-28T00:00:00Z"), Stop: mustParse("2018-03-01T00:00:00Z")},
+			},
+		},
+		{
+			name:  "every year",
+			fn:    `intervals(every:1y)`,
+			start: "2019-06-01T00:00:00Z",
+			stop:  "2020-06-01T00:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2019-01-01T00:00:00Z"), Stop: mustParse("2020-01-01T00:00:00Z")},
+				{Start: mustParse("2020-01-01T00:00:00Z"), Stop: mustParse("2021-01-01T00:00:00Z")},
+			},
+		},
+		{
+			name:  "days in location",
+			fn:    `intervals(every:1d, location:loadLocation(name:"America/Denver"))`,
+			start: "2019-03-09T12:00:00-07:00",
+			stop:  "2019-03-11T12:00:00-06:00",
+			want: []execute.Bounds{
+				{Start: mustParse("2019-03-09T00:00:00-07:00"), Stop: mustParse("2019-03-10T00:00:00-07:00")},
+				{Start: mustParse("2019-03-10T00:00:00-07:00"), Stop: mustParse("2019-03-11T00:00:00-06:00")},
+				{Start: mustParse("2019-03-11T00:00:00-06:00"), Stop: mustParse("2019-03-12T00:00:00-06:00")},
+			},
+		},
+		{
+			name:   "days in location option",
+			option: `option location = loadLocation(name:"America/Denver")`,
+			fn:     `intervals(every:1d)`,
+			start:  "2019-03-09T12:00:00-07:00",
+			stop:   "2019-03-11T12:00:00-06:00",
+			want: []execute.Bounds{
+				{Start: mustParse("2019-03-09T00:00:00-07:00"), Stop: mustParse("2019-03-10T00:00:00-07:00")},
+				{Start: mustParse("2019-03-10T00:00:00-07:00"), Stop: mustParse("2019-03-11T00:00:00-06:00")},
+				{Start: mustParse("2019-03-11T00:00:00-06:00"), Stop: mustParse("2019-03-12T00:00:00-06:00")},
+			},
+		},
+		{
+			name:   "location overrides location option",
+			option: `option location = loadLocation(name:"America/Denver")`,
+			fn:     `intervals(every:1d, location:fixedZone(offset:0s))`,
+			start:  "2019-03-09T12:00:00Z",
+			stop:   "2019-03-10T12:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2019-03-09T00:00:00Z"), Stop: mustParse("2019-03-10T00:00:00Z")},
+				{Start: mustParse("2019-03-10T00:00:00Z"), Stop: mustParse("2019-03-11T00:00:00Z")},
+			},
+		},
+		{
+			name:  "hours in fixed zone",
+			fn:    `intervals(every:1h, location:fixedZone(offset:-30m))`,
+			start: "2019-03-09T12:00:00Z",
+			stop:  "2019-03-09T13:00:00Z",
+			want: []execute.Bounds{
+				{Start: mustParse("2019-03-09T11:30:00Z"), Stop: mustParse("2019-03-09T12:30:00Z")},
+				{Start: mustParse("2019-03-09T12:30:00Z"), Stop: mustParse("2019-03-09T13:30:00Z")},
+			},
+		},
+		{
+			name:  "empty range",
+			fn:    `intervals(every:1h)`,
+			start: "2018-09-05T00:00:00Z",
+			stop:  "2018-09-05T00:00:00Z",
+			want:  []execute.Bounds{},
+		},
+		{
+			name:    "missing every and period",
+			fn:      `intervals(offset:1h)`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown location",
+			fn:      `intervals(every:1d, location:loadLocation(name:"Nowhere/Special"))`,
+			wantErr: true,
+		},
+		{
+			name:    "negative every",
+			fn:      `intervals(every:-1h)`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, scope, err := flux.Eval(tc.option + "\nf = " + tc.fn)
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			f, ok := scope.Lookup("f")
+			if !ok {
+				t.Fatal("missing intervals generator")
+			}
+			v, err := f.Function().Call(values.NewObjectWithValues(map[string]values.Value{
+				"start": values.NewTime(mustParse(tc.start)),
+				"stop":  values.NewTime(mustParse(tc.stop)),
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]execute.Bounds, 0, v.Array().Len())
+			v.Array().Range(func(i int, v values.Value) {
+				start, _ := v.Object().Get("start")
+				stop, _ := v.Object().Get("stop")
+				got = append(got, execute.Bounds{Start: start.Time(), Stop: stop.Time()})
+			})
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected intervals -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
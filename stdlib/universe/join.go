@@ -22,9 +22,10 @@ const MergeJoinKind = "merge-join"
 func init() {
 	joinSignature := semantic.FunctionPolySignature{
 		Parameters: map[string]semantic.PolyType{
-			"tables": semantic.NewObjectPolyType(nil, nil, semantic.AllLabels()),
-			"on":     semantic.NewArrayPolyType(semantic.String),
-			"method": semantic.String,
+			"tables":    semantic.NewObjectPolyType(nil, nil, semantic.AllLabels()),
+			"on":        semantic.NewArrayPolyType(semantic.String),
+			"method":    semantic.String,
+			"tolerance": semantic.Duration,
 		},
 		Required: semantic.LabelSet{"tables"},
 		Return:   flux.TableObjectType,
@@ -39,6 +40,10 @@ func init() {
 // All supported join types in Flux
 var methods = map[string]bool{
 	"inner": true,
+	"left":  true,
+	"right": true,
+	"full":  true,
+	"asof":  true,
 }
 
 // JoinOpSpec specifies a particular join operation
@@ -46,6 +51,7 @@ type JoinOpSpec struct {
 	TableNames map[flux.OperationID]string `json:"tableNames"`
 	On         []string                    `json:"on"`
 	Method     string                      `json:"method"`
+	Tolerance  flux.Duration               `json:"tolerance"`
 
 	// Note: this field below is non-exported and is not part of the public Flux.Spec
 	// interface (used by the transpiler).  It should not be assumed to be populated
@@ -112,11 +118,34 @@ func createJoinOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 		return nil, errors.New("cross product and 'on' are mutually exclusive")
 	}
 
+	// Tolerance is the maximum distance in time between
+	// the rows matched by an as-of join. Zero means no limit.
+	if tolerance, ok, err := args.GetDuration("tolerance"); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.Method != "asof" {
+			return nil, errors.New("tolerance is only valid for the asof join method")
+		}
+		if tolerance.IsNegative() {
+			return nil, errors.New("tolerance must not be negative")
+		}
+		spec.Tolerance = tolerance
+	}
+
 	tables, err := args.GetRequiredObject("tables")
 	if err != nil {
 		return nil, err
 	}
 
+	if spec.Method == "asof" {
+		if tables.Len() != 2 {
+			return nil, errors.New("asof join requires exactly two tables")
+		}
+		if !execute.ContainsStr(spec.On, execute.DefaultTimeColLabel) {
+			return nil, fmt.Errorf("asof join requires %q to be part of 'on'", execute.DefaultTimeColLabel)
+		}
+	}
+
 	spec.TableNames = make(map[flux.OperationID]string, tables.Len())
 	spec.params = newJoinParams(tables.Len())
 	tables.Range(func(name string, operation values.Value) {
@@ -166,9 +195,26 @@ func (s *JoinOpSpec) Kind() flux.OperationKind {
 }
 
 type MergeJoinProcedureSpec struct {
-	plan.DefaultCost
-	TableNames []string `json:"table_names"`
-	On         []string `json:"keys"`
+	TableNames []string      `json:"table_names"`
+	On         []string      `json:"keys"`
+	Method     string        `json:"method"`
+	Tolerance  flux.Duration `json:"tolerance"`
+}
+
+// Cost estimates that the join buffers all of its input and that each row
+// of the larger input joins with a single row of the others.
+func (s *MergeJoinProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	var out plan.Statistics
+	for _, stats := range inStats {
+		if stats.Cardinality > out.Cardinality {
+			out.Cardinality = stats.Cardinality
+		}
+		if stats.GroupCardinality > out.GroupCardinality {
+			out.GroupCardinality = stats.GroupCardinality
+		}
+	}
+	return plan.Cost{CPU: in.Cardinality, MEM: in.Cardinality}, out
 }
 
 func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -195,6 +241,8 @@ func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.
 	return &MergeJoinProcedureSpec{
 		On:         on,
 		TableNames: tableNames,
+		Method:     spec.Method,
+		Tolerance:  spec.Tolerance,
 	}, nil
 }
 
@@ -207,6 +255,12 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.On = make([]string, len(s.On))
 	copy(ns.On, s.On)
 
+	ns.TableNames = make([]string, len(s.TableNames))
+	copy(ns.TableNames, s.TableNames)
+
+	ns.Method = s.Method
+	ns.Tolerance = s.Tolerance
+
 	return ns
 }
 
@@ -216,9 +270,11 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
 	parents := a.Parents()
-	if len(parents) != 2 {
-		//TODO(nathanielc): Support n-way joins
-		return nil, nil, errors.New("joins currently must only have two parents")
+	if len(parents) < 2 {
+		return nil, nil, errors.New("joins must have at least two parents")
+	}
+	if s.Method == "asof" && len(parents) != 2 {
+		return nil, nil, errors.New("asof joins must have exactly two parents")
 	}
 
 	tableNames := make(map[execute.DatasetID]string, len(s.TableNames))
@@ -226,7 +282,8 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 		tableNames[parents[i]] = name
 	}
 
-	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On)
+	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On, s.Method, execute.Duration(s.Tolerance))
+	cache.spiller = execute.NewSpiller(execute.GetSpillConfig(a.Dependencies()), a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -238,9 +295,6 @@ type mergeJoinTransformation struct {
 	d     execute.Dataset
 	cache *MergeJoinCache
 
-	leftID, rightID     execute.DatasetID
-	leftName, rightName string
-
 	parentState map[execute.DatasetID]*mergeJoinParentState
 	err         error
 
@@ -249,13 +303,9 @@ type mergeJoinTransformation struct {
 
 func NewMergeJoinTransformation(d execute.Dataset, cache *MergeJoinCache, spec *MergeJoinProcedureSpec, parents []execute.DatasetID, tableNames map[execute.DatasetID]string) *mergeJoinTransformation {
 	t := &mergeJoinTransformation{
-		d:         d,
-		cache:     cache,
-		keys:      spec.On,
-		leftID:    parents[0],
-		rightID:   parents[1],
-		leftName:  tableNames[parents[0]],
-		rightName: tableNames[parents[1]],
+		d:     d,
+		cache: cache,
+		keys:  spec.On,
 	}
 	t.parentState = make(map[execute.DatasetID]*mergeJoinParentState)
 	for _, id := range parents {
@@ -286,7 +336,7 @@ func (t *mergeJoinTransformation) Process(id execute.DatasetID, tbl flux.Table)
 	}
 
 	// Check if enough data sources have been seen to produce an output schema
-	if !t.cache.isBufferEmpty(t.leftID) && !t.cache.isBufferEmpty(t.rightID) && !t.cache.postJoinSchemaBuilt() {
+	if !t.cache.postJoinSchemaBuilt() && !t.cache.anyBufferEmpty() {
 		t.cache.buildPostJoinSchema()
 	}
 
@@ -341,7 +391,13 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 	}
 
 	if finished {
+		if t.err == nil {
+			// Rows from an outer stream that never found a match
+			// can only be identified once every input has finished.
+			t.err = t.cache.registerUnmatchedKeys()
+		}
 		t.d.Finish(t.err)
+		t.cache.spiller.Close()
 	}
 }
 
@@ -358,13 +414,28 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 // reverseLookup:   Each output group key that is stored is mapped to its
 //                  corresponding pre-join group keys. These pre-join group
 //                  keys are then used to retrieve their corresponding
-//                  tables from the buffers.
+//                  tables from the buffers. Several combinations of tables
+//                  may produce the same output group key, in which case
+//                  their joined rows make up a single output table.
+//
+// joinKeys:        The join key values of the rows of each stream. They are
+//                  collected once every input has finished, to find the rows
+//                  of an outer join that have no match in any table.
 //
 // tables:          All output tables are materialized and stored in this
 //                  map before being sent to downstream operators.
+//
+// spiller:         When the query is about to reach its memory limit, the
+//                  buffered tables and the output tables are written to disk.
+//                  A spilled input table is read back only while it is joined.
 type MergeJoinCache struct {
-	leftID  execute.DatasetID
-	rightID execute.DatasetID
+	// ids holds the input streams in the order of their table names.
+	ids    []execute.DatasetID
+	method string
+
+	// tolerance is the maximum distance in time between
+	// the rows matched by an as-of join.
+	tolerance execute.Duration
 
 	names   map[execute.DatasetID]string
 	schemas map[execute.DatasetID]schema
@@ -379,18 +450,25 @@ type MergeJoinCache struct {
 	schemaMap map[tableCol]flux.ColMeta
 
 	postJoinKeys  *execute.GroupLookup
-	reverseLookup map[flux.GroupKey]preJoinGroupKeys
+	reverseLookup map[flux.GroupKey][]preJoinGroupKeys
+	joinKeys      []*execute.GroupLookup
 
 	tables      map[flux.GroupKey]flux.Table
 	alloc       *memory.Allocator
+	spiller     *execute.Spiller
 	triggerSpec plan.TriggerSpec
 }
 
 type streamBuffer struct {
-	data     map[flux.GroupKey]*execute.ColListTableBuilder
+	data map[flux.GroupKey]*execute.ColListTableBuilder
+	// spilled holds the tables that were written to disk.
+	spilled map[flux.GroupKey]spilledBuffer
+	// loaded marks the spilled tables that have been read back into data.
+	loaded   map[flux.GroupKey]bool
 	consumed map[values.Value]int
 	ready    map[values.Value]bool
 	stale    map[flux.GroupKey]bool
+	matched  map[flux.GroupKey]bool
 	last     values.Value
 	alloc    *memory.Allocator
 }
@@ -398,14 +476,20 @@ type streamBuffer struct {
 func newStreamBuffer(alloc *memory.Allocator) *streamBuffer {
 	return &streamBuffer{
 		data:     make(map[flux.GroupKey]*execute.ColListTableBuilder),
+		spilled:  make(map[flux.GroupKey]spilledBuffer),
+		loaded:   make(map[flux.GroupKey]bool),
 		consumed: make(map[values.Value]int),
 		ready:    make(map[values.Value]bool),
 		stale:    make(map[flux.GroupKey]bool),
+		matched:  make(map[flux.GroupKey]bool),
 		alloc:    alloc,
 	}
 }
 
 func (buf *streamBuffer) table(key flux.GroupKey) *execute.ColListTableBuilder {
+	if key == nil {
+		return nil
+	}
 	return buf.data[key]
 }
 
@@ -425,9 +509,153 @@ func (buf *streamBuffer) insert(table flux.Table) error {
 
 	// Insert this table into the buffer
 	buf.data[table.Key()] = builder
+	buf.register(table.Key())
+	return nil
+}
+
+// spilledBuffer is a buffered table that was written to disk.
+type spilledBuffer struct {
+	table flux.Table
+	nrows int
+}
+
+// insertSpilled adds a table to the buffer, writing it to disk once there is not enough memory to hold it.
+// The buffered tables of every stream are written to disk first by calling spillAll.
+func (buf *streamBuffer) insertSpilled(table flux.Table, spiller *execute.Spiller, spillAll func() error) error {
+	builder := execute.NewColListTableBuilder(table.Key(), buf.alloc)
+	if err := execute.AddTableCols(table, builder); err != nil {
+		return err
+	}
+
+	var (
+		w     *execute.SpillWriter
+		nrows int
+	)
+	if len(table.Cols()) > 0 {
+		if err := table.Do(func(cr flux.ColReader) error {
+			nrows += cr.Len()
+			if w == nil && spiller.ShouldSpill(execute.AppendSize(builder, cr)) {
+				if err := spillAll(); err != nil {
+					return err
+				}
+				if spiller.ShouldSpill(execute.AppendSize(builder, cr)) {
+					var err error
+					if w, err = spiller.NewWriter(table.Key(), table.Cols()); err != nil {
+						return err
+					}
+					if err := w.WriteBuilder(builder); err != nil {
+						return err
+					}
+					builder.ClearData()
+				}
+			}
+			if w != nil {
+				return w.Write(cr)
+			}
+			return execute.AppendCols(cr, builder)
+		}); err != nil {
+			if w != nil {
+				w.Close()
+			}
+			return err
+		}
+	}
 
-	if len(table.Key().Cols()) > 0 {
-		leftKeyValue := table.Key().Value(0)
+	if w != nil {
+		spilled, err := w.Table()
+		if err != nil {
+			return err
+		}
+		buf.spilled[table.Key()] = spilledBuffer{table: spilled, nrows: nrows}
+	} else {
+		buf.data[table.Key()] = builder
+	}
+	buf.register(table.Key())
+	return nil
+}
+
+// spill writes every table in memory to disk.
+func (buf *streamBuffer) spill(spiller *execute.Spiller) error {
+	for key, builder := range buf.data {
+		if buf.loaded[key] {
+			continue
+		}
+		tbl, err := spiller.SpillBuilder(builder)
+		if err != nil {
+			return err
+		}
+		buf.spilled[key] = spilledBuffer{table: tbl, nrows: builder.NRows()}
+		builder.ClearData()
+		delete(buf.data, key)
+	}
+	return nil
+}
+
+// load reads a spilled table back into memory until unload is called.
+func (buf *streamBuffer) load(key flux.GroupKey) error {
+	s, ok := buf.spilled[key]
+	if !ok || buf.loaded[key] {
+		return nil
+	}
+	builder := execute.NewColListTableBuilder(key, buf.alloc)
+	if err := execute.AddTableCols(s.table, builder); err != nil {
+		return err
+	}
+	if err := execute.AppendTable(s.table, builder); err != nil {
+		return err
+	}
+	buf.data[key] = builder
+	buf.loaded[key] = true
+	return nil
+}
+
+// size estimates the number of bytes used by a buffered table once it is in memory.
+func (buf *streamBuffer) size(key flux.GroupKey) int64 {
+	if builder, ok := buf.data[key]; ok {
+		return execute.TableSize(builder.NRows(), builder.Cols())
+	}
+	s := buf.spilled[key]
+	return execute.TableSize(s.nrows, s.table.Cols())
+}
+
+// loadSize estimates the number of bytes needed to read a spilled table back into memory.
+func (buf *streamBuffer) loadSize(key flux.GroupKey) int64 {
+	if _, ok := buf.spilled[key]; !ok || buf.loaded[key] {
+		return 0
+	}
+	return buf.size(key)
+}
+
+// unload releases the memory of the spilled tables that were read back.
+func (buf *streamBuffer) unload() {
+	for key := range buf.loaded {
+		buf.data[key].ClearData()
+		delete(buf.data, key)
+		delete(buf.loaded, key)
+	}
+}
+
+// has reports whether the buffer holds a table with the key.
+func (buf *streamBuffer) has(key flux.GroupKey) bool {
+	if _, ok := buf.data[key]; ok {
+		return true
+	}
+	_, ok := buf.spilled[key]
+	return ok
+}
+
+// nrows returns the number of rows of a buffered table.
+func (buf *streamBuffer) nrows(key flux.GroupKey) int {
+	if builder, ok := buf.data[key]; ok {
+		return builder.NRows()
+	}
+	return buf.spilled[key].nrows
+}
+
+// register records that the table with the key was added to the buffer.
+func (buf *streamBuffer) register(key flux.GroupKey) {
+	if len(key.Cols()) > 0 {
+		leftKeyValue := key.Value(0)
 
 		tablesConsumed := buf.consumed[leftKeyValue]
 		buf.consumed[leftKeyValue] = tablesConsumed + 1
@@ -441,10 +669,12 @@ func (buf *streamBuffer) insert(table flux.Table) error {
 			buf.last = leftKeyValue
 		}
 	}
-	return nil
 }
 
 func (buf *streamBuffer) expire(key flux.GroupKey) {
+	if key == nil {
+		return
+	}
 	if !buf.stale[key] && len(key.Cols()) > 0 {
 		leftKeyValue := key.Value(0)
 		consumedTables := buf.consumed[leftKeyValue]
@@ -457,6 +687,12 @@ func (buf *streamBuffer) evict(key flux.GroupKey) {
 	if builder, ok := buf.data[key]; ok {
 		builder.ClearData()
 		delete(buf.data, key)
+		delete(buf.loaded, key)
+		delete(buf.matched, key)
+	}
+	if _, ok := buf.spilled[key]; ok {
+		delete(buf.spilled, key)
+		delete(buf.matched, key)
 	}
 }
 
@@ -471,6 +707,11 @@ func (buf *streamBuffer) clear(f func(flux.GroupKey) bool) {
 
 func (buf *streamBuffer) iterate(f func(flux.GroupKey)) {
 	for key := range buf.data {
+		if !buf.loaded[key] {
+			f(key)
+		}
+	}
+	for key := range buf.spilled {
 		f(key)
 	}
 }
@@ -479,9 +720,11 @@ type tableCol struct {
 	table, col string
 }
 
-type preJoinGroupKeys struct {
-	left, right flux.GroupKey
-}
+// preJoinGroupKeys holds the input group keys that make up an output table,
+// one for each input stream in the order of the stream table names.
+// When performing an outer join, the key for a stream that has no matching
+// table is nil.
+type preJoinGroupKeys []flux.GroupKey
 
 type schema struct {
 	key     []flux.ColMeta
@@ -500,13 +743,12 @@ func (s schema) Swap(i int, j int) {
 	s.columns[i], s.columns[j] = s.columns[j], s.columns[i]
 }
 
-// NewMergeJoinCache constructs a new instance of a MergeJoinCache
-func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string) *MergeJoinCache {
-	// Join currently only accepts two data sources(streams) as input
-	if len(datasetIDs) != 2 {
-		panic("Join only accepts two data sources")
-	}
-
+// NewMergeJoinCache constructs a new instance of a MergeJoinCache.
+// The dataset ids must be ordered by their table names.
+// The method must be one of the supported join methods.
+// An empty method is treated as an inner join.
+// The tolerance is only used by as-of joins, zero means no limit.
+func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string, method string, tolerance execute.Duration) *MergeJoinCache {
 	names := make(map[execute.DatasetID]string, len(datasetIDs))
 	schemas := make(map[execute.DatasetID]schema, len(datasetIDs))
 	buffers := make(map[execute.DatasetID]*streamBuffer, len(datasetIDs))
@@ -521,28 +763,156 @@ func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID,
 
 	for _, k := range key {
 		on[k] = true
-		intersection[k] = true
+		// The time column of an as-of join is not matched exactly
+		// so it cannot be used to pair tables with each other.
+		if method != "asof" || k != execute.DefaultTimeColLabel {
+			intersection[k] = true
+		}
 	}
 
+	ids := make([]execute.DatasetID, len(datasetIDs))
+	copy(ids, datasetIDs)
+
 	return &MergeJoinCache{
 		on:            on,
 		order:         key,
 		intersection:  intersection,
-		leftID:        datasetIDs[0],
-		rightID:       datasetIDs[1],
+		ids:           ids,
+		method:        method,
+		tolerance:     tolerance,
 		names:         names,
 		schemas:       schemas,
 		buffers:       buffers,
-		reverseLookup: make(map[flux.GroupKey]preJoinGroupKeys),
+		reverseLookup: make(map[flux.GroupKey][]preJoinGroupKeys),
 		postJoinKeys:  execute.NewGroupLookup(),
 		tables:        make(map[flux.GroupKey]flux.Table),
 		alloc:         alloc,
+		spiller:       execute.NewSpiller(nil, alloc),
+	}
+}
+
+// builders returns the buffered tables associated with the pre-join group keys.
+func (c *MergeJoinCache) builders(keys preJoinGroupKeys) []*execute.ColListTableBuilder {
+	builders := make([]*execute.ColListTableBuilder, len(c.ids))
+	for i, id := range c.ids {
+		builders[i] = c.buffers[id].table(keys[i])
+	}
+	return builders
+}
+
+// joinTables joins the buffered tables associated with the pre-join group keys.
+// Tables that were written to disk are read back only for the duration of the join,
+// and the output table is written to disk when the query is low on memory.
+func (c *MergeJoinCache) joinTables(keys preJoinGroupKeys) (flux.Table, error) {
+	defer func() {
+		for _, id := range c.ids {
+			c.buffers[id].unload()
+		}
+	}()
+	// Joining copies the tables, and the joined rows need about as much memory again.
+	var load, size int64
+	for i, id := range c.ids {
+		if keys[i] != nil {
+			load += c.buffers[id].loadSize(keys[i])
+			size += c.buffers[id].size(keys[i])
+		}
+	}
+	if c.spiller.ShouldSpill(load + 2*size) {
+		if err := c.spillAll(); err != nil {
+			return nil, err
+		}
+	}
+	for i, id := range c.ids {
+		if keys[i] != nil {
+			if err := c.buffers[id].load(keys[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	table, err := c.join(c.builders(keys))
+	if err != nil {
+		return nil, err
+	}
+	if tbl, ok := table.(*execute.ColListTable); ok && c.spiller.ShouldSpill(execute.TableSize(tbl.NRows(), tbl.Cols())) {
+		return c.spillTable(table)
 	}
+	return table, nil
 }
 
-// Table joins the two tables associated with a single output group key and returns the resulting table
+// spillTable writes an output table to disk and releases its memory.
+func (c *MergeJoinCache) spillTable(table flux.Table) (flux.Table, error) {
+	// Hold a reference while the table is written so that its memory is released afterwards.
+	table.RefCount(1)
+	defer table.RefCount(-1)
+	return c.spiller.Spill(table)
+}
+
+// spillAll writes the buffered tables of every stream
+// and the output tables that are held in memory to disk.
+func (c *MergeJoinCache) spillAll() error {
+	for _, id := range c.ids {
+		if err := c.buffers[id].spill(c.spiller); err != nil {
+			return err
+		}
+	}
+	for key, table := range c.tables {
+		if _, ok := table.(*execute.ColListTable); !ok {
+			continue
+		}
+		spilled, err := c.spillTable(table)
+		if err != nil {
+			return err
+		}
+		c.tables[key] = spilled
+	}
+	return nil
+}
+
+// outputTable joins every combination of tables associated with an output group key.
+// The rows of the combinations are merged into a single table sorted on the join columns.
+func (c *MergeJoinCache) outputTable(key flux.GroupKey) (flux.Table, error) {
+	combinations := c.reverseLookup[key]
+	if len(combinations) == 1 {
+		return c.joinTables(combinations[0])
+	}
+
+	builder := execute.NewColListTableBuilder(key, c.alloc)
+	for _, column := range c.schema.columns {
+		if _, err := builder.AddCol(column); err != nil {
+			return nil, err
+		}
+	}
+	for _, keys := range combinations {
+		table, err := c.joinTables(keys)
+		if err != nil {
+			return nil, err
+		}
+		// Hold a reference while the rows are copied so that the memory of the table is released afterwards.
+		table.RefCount(1)
+		err = execute.AppendTable(table, builder)
+		table.RefCount(-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	builder.Sort(c.order, false)
+
+	// The table is a copy of the rows, so the memory of the builder can be released.
+	table, err := builder.Table()
+	builder.ClearData()
+	if err != nil {
+		return nil, err
+	}
+	if tbl, ok := table.(*execute.ColListTable); ok && c.spiller.ShouldSpill(execute.TableSize(tbl.NRows(), tbl.Cols())) {
+		return c.spillTable(table)
+	}
+	return table, nil
+}
+
+// Table joins the tables associated with a single output group key and returns the resulting table
 func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
-	preJoinGroupKeys, ok := c.reverseLookup[key]
+	combinations, ok := c.reverseLookup[key]
 
 	if !ok {
 		return nil, fmt.Errorf("no table exists with group key: %v", key)
@@ -550,17 +920,15 @@ func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
 
 	if _, ok := c.tables[key]; !ok {
 
-		left := c.buffers[c.leftID].table(preJoinGroupKeys.left)
-		if left == nil {
-			return nil, fmt.Errorf("no table in left join buffer with key: %v", key)
-		}
-
-		right := c.buffers[c.rightID].table(preJoinGroupKeys.right)
-		if left == nil {
-			return nil, fmt.Errorf("no table in right join buffer with key: %v", key)
+		for _, preJoinGroupKeys := range combinations {
+			for i, id := range c.ids {
+				if preJoinGroupKeys[i] != nil && !c.buffers[id].has(preJoinGroupKeys[i]) {
+					return nil, fmt.Errorf("no table in %s join buffer with key: %v", c.names[id], key)
+				}
+			}
 		}
 
-		table, err := c.join(left, right)
+		table, err := c.outputTable(key)
 		if err != nil {
 			return nil, fmt.Errorf("table with group key (%v) could not be fetched", key)
 		}
@@ -576,15 +944,7 @@ func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
 
 		if _, ok := c.tables[key]; !ok {
 
-			preJoinGroupKeys := c.reverseLookup[key]
-
-			leftKey := preJoinGroupKeys.left
-			rightKey := preJoinGroupKeys.right
-
-			leftBuilder := c.buffers[c.leftID].table(leftKey)
-			rightBuilder := c.buffers[c.rightID].table(rightKey)
-
-			table, err := c.join(leftBuilder, rightBuilder)
+			table, err := c.outputTable(key)
 			if err != nil || table.Empty() {
 				c.DiscardTable(key)
 				return
@@ -602,17 +962,9 @@ func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigge
 
 	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
 
-		preJoinGroupKeys := c.reverseLookup[key]
-
-		leftKey := preJoinGroupKeys.left
-		rightKey := preJoinGroupKeys.right
-
-		leftBuilder := c.buffers[c.leftID].table(leftKey)
-		rightBuilder := c.buffers[c.rightID].table(rightKey)
-
 		if _, ok := c.tables[key]; !ok {
 
-			table, err := c.join(leftBuilder, rightBuilder)
+			table, err := c.outputTable(key)
 
 			if err != nil || table.Empty() {
 				c.DiscardTable(key)
@@ -622,12 +974,18 @@ func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigge
 			c.tables[key] = table
 		}
 
-		leftsize := leftBuilder.NRows()
-		rightsize := rightBuilder.NRows()
+		var count int
+		for _, preJoinGroupKeys := range c.reverseLookup[key] {
+			for i, id := range c.ids {
+				if preJoinGroupKeys[i] != nil {
+					count += c.buffers[id].nrows(preJoinGroupKeys[i])
+				}
+			}
+		}
 
 		ctx := execute.TableContext{
 			Key:   key,
-			Count: leftsize + rightsize,
+			Count: count,
 		}
 
 		f(key, trigger, ctx)
@@ -649,25 +1007,32 @@ func (c *MergeJoinCache) ExpireTable(key flux.GroupKey) {
 	delete(c.tables, key)
 
 	// Clear any stale data
-	preJoinGroupKeys := c.reverseLookup[key]
-
-	leftBuffer := c.buffers[c.leftID]
-	rightBuffer := c.buffers[c.rightID]
-
-	leftBuffer.expire(preJoinGroupKeys.left)
-	rightBuffer.expire(preJoinGroupKeys.right)
+	for _, preJoinGroupKeys := range c.reverseLookup[key] {
+		for i, id := range c.ids {
+			if i < len(preJoinGroupKeys) {
+				c.buffers[id].expire(preJoinGroupKeys[i])
+			}
+		}
+	}
 
 	if c.canEvictTables() {
-
-		leftBuffer.clear(func(key flux.GroupKey) bool {
-			return rightBuffer.ready[key.Value(0)] &&
-				rightBuffer.consumed[key.Value(0)] == 0
-		})
-
-		rightBuffer.clear(func(key flux.GroupKey) bool {
-			return leftBuffer.ready[key.Value(0)] &&
-				leftBuffer.consumed[key.Value(0)] == 0
-		})
+		for _, id := range c.ids {
+			id := id
+			c.buffers[id].clear(func(key flux.GroupKey) bool {
+				// A table can only be evicted once every other stream
+				// has moved past its leading group key value.
+				for _, other := range c.ids {
+					if other == id {
+						continue
+					}
+					buf := c.buffers[other]
+					if !buf.ready[key.Value(0)] || buf.consumed[key.Value(0)] != 0 {
+						return false
+					}
+				}
+				return true
+			})
+		}
 	}
 }
 
@@ -676,13 +1041,86 @@ func (c *MergeJoinCache) SetTriggerSpec(spec plan.TriggerSpec) {
 	c.triggerSpec = spec
 }
 
+// isOuter reports whether rows from the stream at index i
+// must be kept even when they have no match in the other streams.
+// The left stream is the first stream and the right stream is the last one.
+func (c *MergeJoinCache) isOuter(i int) bool {
+	switch c.method {
+	case "left", "asof":
+		return i == 0
+	case "right":
+		return i == len(c.ids)-1
+	case "full":
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldEmit reports whether rows are produced when only the streams
+// marked in present have rows for a given join key.
+func (c *MergeJoinCache) shouldEmit(present []bool) bool {
+	switch c.method {
+	case "left":
+		return present[0]
+	case "right":
+		return present[len(present)-1]
+	case "full":
+		return true
+	default:
+		for _, ok := range present {
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// complete reports whether the rows with the given join key are joined by the
+// combination of tables. Every table of the combination must have rows with the key.
+// A stream that is missing from the combination must not have any row with the key,
+// since the rows are then joined with the tables of that stream instead.
+func (c *MergeJoinCache) complete(tables []*execute.ColListTableBuilder, present []bool, key flux.GroupKey) bool {
+	for i, table := range tables {
+		if table != nil {
+			if !present[i] {
+				return false
+			}
+			continue
+		}
+		if c.joinKeys == nil {
+			return false
+		}
+		if _, ok := c.joinKeys[i].Lookup(key); ok {
+			return false
+		}
+	}
+	return true
+}
+
 // Currently tables are the smallest unit of data that can be evicted from the join's internal
 // buffers. This is the rule that specifies whether a data cache can early evict tables.
+// The rows of an outer join without a match are only known once every input
+// has finished, so the tables of an outer join are never evicted early.
 func (c *MergeJoinCache) canEvictTables() bool {
-	leftKey := c.schemas[c.leftID].key
-	rightKey := c.schemas[c.rightID].key
-	return len(leftKey) > 0 && len(rightKey) > 0 &&
-		leftKey[0].Label == rightKey[0].Label && c.on[leftKey[0].Label]
+	switch c.method {
+	case "left", "right", "full":
+		return false
+	}
+	var label string
+	for i, id := range c.ids {
+		key := c.schemas[id].key
+		if len(key) == 0 {
+			return false
+		}
+		if i == 0 {
+			label = key[0].Label
+		} else if key[0].Label != label {
+			return false
+		}
+	}
+	return c.on[label]
 }
 
 // insertIntoBuffer adds the rows of an incoming table to one of the Join's internal buffers
@@ -712,10 +1150,11 @@ func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table)
 
 	// Optimization: if any group key columns overlap join key columns,
 	// and there are any nulls in those columns, we can discard this table,
-	// since null != null for joining purposes.
+	// since null != null for joining purposes. Tables from an outer stream
+	// must be kept since their rows are part of the output regardless.
 	k := tbl.Key()
 	for j, col := range k.Cols() {
-		if c.on[col.Label] {
+		if c.on[col.Label] && !c.isOuter(c.index(id)) {
 			if k.IsNull(j) {
 				// Discard the table and return.  Note: we need to iterate over the
 				// table at least once:
@@ -726,69 +1165,256 @@ func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table)
 			}
 		}
 	}
+	if c.spiller.Enabled() {
+		return c.buffers[id].insertSpilled(tbl, c.spiller, c.spillAll)
+	}
 	return c.buffers[id].insert(tbl)
 }
 
-// registerKey takes a group key from the input stream associated with id and joins
-// it with all other group keys from the opposing input stream. If it is determined
-// that two group keys will not join (due to having different values on a join column)
-// they are skipped.
-func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
-	var empty struct{}
-	switch id {
+// index returns the position of the stream associated with id.
+func (c *MergeJoinCache) index(id execute.DatasetID) int {
+	for i, datasetID := range c.ids {
+		if datasetID == id {
+			return i
+		}
+	}
+	return -1
+}
 
-	case c.leftID:
+// compatible reports whether two group keys from different input streams
+// agree on the join columns that are part of the group key of every stream.
+func (c *MergeJoinCache) compatible(key, other flux.GroupKey) bool {
+	for k := range c.intersection {
+		if !key.LabelValue(k).Equal(other.LabelValue(k)) {
+			return false
+		}
+	}
+	return true
+}
 
-		c.buffers[c.rightID].iterate(func(groupKey flux.GroupKey) {
+// combinations calls f for every combination of buffered tables, one from each
+// stream, that can be joined with the table from the stream at index i with the
+// given group key. When allowMissing is set, a stream without any matching table
+// is represented by a nil key instead of preventing the combination.
+func (c *MergeJoinCache) combinations(i int, key flux.GroupKey, allowMissing bool, f func(preJoinGroupKeys)) {
+	keys := make(preJoinGroupKeys, len(c.ids))
+	keys[i] = key
+
+	var next func(j int)
+	next = func(j int) {
+		if j == len(c.ids) {
+			combination := make(preJoinGroupKeys, len(keys))
+			copy(combination, keys)
+			f(combination)
+			return
+		}
+		if j == i {
+			next(j + 1)
+			return
+		}
 
-			keys := map[execute.DatasetID]flux.GroupKey{
-				c.leftID:  key,
-				c.rightID: groupKey,
+		found := false
+		c.buffers[c.ids[j]].iterate(func(groupKey flux.GroupKey) {
+			if !c.compatible(key, groupKey) {
+				return
 			}
+			found = true
+			keys[j] = groupKey
+			next(j + 1)
+		})
+		if !found && allowMissing {
+			keys[j] = nil
+			next(j + 1)
+		}
+	}
+	next(0)
+}
 
-			for k := range c.intersection {
-				if !key.LabelValue(k).Equal(groupKey.LabelValue(k)) {
-					return
-				}
-			}
+// register stores the output group key for a combination of pre-join group keys.
+func (c *MergeJoinCache) register(keys preJoinGroupKeys) {
+	// The output group keys are stored as their own values so that every
+	// combination with the same output group key uses the same key.
+	outputGroupKey := c.postJoinGroupKey(keys)
+	if v, ok := c.postJoinKeys.Lookup(outputGroupKey); ok {
+		outputGroupKey = v.(flux.GroupKey)
+	} else {
+		c.postJoinKeys.Set(outputGroupKey, outputGroupKey)
+	}
+	combinations := c.reverseLookup[outputGroupKey]
+	for _, other := range combinations {
+		if samePreJoinGroupKeys(keys, other) {
+			return
+		}
+	}
+	c.reverseLookup[outputGroupKey] = append(combinations, keys)
+	// The joined rows of the new combination are part of the output table.
+	delete(c.tables, outputGroupKey)
 
-			outputGroupKey := c.postJoinGroupKey(keys)
-			c.postJoinKeys.Set(outputGroupKey, empty)
+	for i, id := range c.ids {
+		if keys[i] != nil {
+			c.buffers[id].matched[keys[i]] = true
+		}
+	}
+}
+
+// registerKey takes a group key from the input stream associated with id and joins
+// it with all other group keys from the opposing input streams. If it is determined
+// that group keys will not join (due to having different values on a join column)
+// they are skipped.
+func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
+	c.combinations(c.index(id), key, false, c.register)
+}
+
+// samePreJoinGroupKeys reports whether two combinations are made of the same tables.
+func samePreJoinGroupKeys(keys, other preJoinGroupKeys) bool {
+	for i, key := range keys {
+		if (key == nil) != (other[i] == nil) || key != nil && !key.Equal(other[i]) {
+			return false
+		}
+	}
+	return true
+}
 
-			c.reverseLookup[outputGroupKey] = preJoinGroupKeys{
-				left:  key,
-				right: groupKey,
+// registerUnmatchedKeys registers the output group keys of the rows of an outer
+// join that have no match. Whether a row has a match in another stream is only
+// known once every table of that stream has been seen, so this is called once
+// every input has finished.
+func (c *MergeJoinCache) registerUnmatchedKeys() error {
+	if c.method == "" || c.method == "inner" {
+		return nil
+	}
+
+	// Some of the streams may not have produced any tables
+	// in which case the output schema has not been built yet.
+	if !c.postJoinSchemaBuilt() {
+		c.buildPostJoinSchema()
+	}
+
+	if c.method == "asof" {
+		// An as-of join keeps the left rows without a match itself, so only the
+		// left tables that were never joined with a right table are registered.
+		// The group key columns of the right stream are set to null.
+		buf := c.buffers[c.ids[0]]
+		buf.iterate(func(key flux.GroupKey) {
+			if buf.matched[key] {
+				return
 			}
+			c.combinations(0, key, true, c.register)
 		})
+		return nil
+	}
 
-	case c.rightID:
+	if err := c.collectJoinKeys(); err != nil {
+		return err
+	}
+	c.outerCombinations(c.register)
+	return nil
+}
 
-		c.buffers[c.leftID].iterate(func(groupKey flux.GroupKey) {
+// collectJoinKeys stores the join key values of the rows of every stream.
+// Rows with a null join value never match, so their keys are left out.
+func (c *MergeJoinCache) collectJoinKeys() error {
+	c.joinKeys = make([]*execute.GroupLookup, len(c.ids))
+	for i, id := range c.ids {
+		lookup := execute.NewGroupLookup()
+		buf := c.buffers[id]
 
-			keys := map[execute.DatasetID]flux.GroupKey{
-				c.leftID:  groupKey,
-				c.rightID: key,
+		var keys []flux.GroupKey
+		buf.iterate(func(key flux.GroupKey) {
+			keys = append(keys, key)
+		})
+		for _, key := range keys {
+			if err := buf.load(key); err != nil {
+				return err
 			}
-
-			for k := range c.intersection {
-				if !key.LabelValue(k).Equal(groupKey.LabelValue(k)) {
-					return
+			tbl, err := buf.table(key).Table()
+			if err != nil {
+				return err
+			}
+			// Release the copy once the keys have been read.
+			tbl.RefCount(1)
+			cr := tbl.(flux.ColReader)
+			for r := 0; r < cr.Len(); r++ {
+				joinKey := execute.GroupKeyForRowOn(r, cr, c.on)
+				if !hasNullValue(joinKey) {
+					lookup.Set(joinKey, true)
 				}
 			}
+			tbl.RefCount(-1)
+			buf.unload()
+		}
+		c.joinKeys[i] = lookup
+	}
+	return nil
+}
 
-			outputGroupKey := c.postJoinGroupKey(keys)
-			c.postJoinKeys.Set(outputGroupKey, empty)
+// hasNullValue reports whether any value of the group key is null.
+func hasNullValue(key flux.GroupKey) bool {
+	for j := range key.Cols() {
+		if key.IsNull(j) {
+			return true
+		}
+	}
+	return false
+}
+
+// outerCombinations calls f for every combination of buffered tables in which
+// some of the streams are missing, as long as the join method keeps the rows
+// of the streams that are present. The key of a missing stream is nil.
+func (c *MergeJoinCache) outerCombinations(f func(preJoinGroupKeys)) {
+	keys := make(preJoinGroupKeys, len(c.ids))
+	present := make([]bool, len(c.ids))
+
+	var next func(j int, first flux.GroupKey)
+	next = func(j int, first flux.GroupKey) {
+		if j == len(c.ids) {
+			missing := false
+			for _, ok := range present {
+				missing = missing || !ok
+			}
+			if first == nil || !missing || !c.shouldEmit(present) {
+				return
+			}
+			combination := make(preJoinGroupKeys, len(keys))
+			copy(combination, keys)
+			f(combination)
+			return
+		}
 
-			c.reverseLookup[outputGroupKey] = preJoinGroupKeys{
-				left:  groupKey,
-				right: key,
+		keys[j], present[j] = nil, false
+		next(j+1, first)
+		c.buffers[c.ids[j]].iterate(func(groupKey flux.GroupKey) {
+			if first != nil && !c.compatible(first, groupKey) {
+				return
+			}
+			keys[j], present[j] = groupKey, true
+			if first == nil {
+				next(j+1, groupKey)
+			} else {
+				next(j+1, first)
 			}
 		})
+		keys[j], present[j] = nil, false
 	}
+	next(0, nil)
 }
 
-func (c *MergeJoinCache) isBufferEmpty(id execute.DatasetID) bool {
-	return len(c.buffers[id].data) == 0
+// nullGroupKey constructs a group key with the given columns where every value is null.
+func nullGroupKey(cols []flux.ColMeta) flux.GroupKey {
+	vs := make([]values.Value, len(cols))
+	for j, col := range cols {
+		vs[j] = values.NewNull(flux.SemanticType(col.Type))
+	}
+	return execute.NewGroupKey(cols, vs)
+}
+
+func (c *MergeJoinCache) anyBufferEmpty() bool {
+	for _, id := range c.ids {
+		if len(c.buffers[id].data) == 0 && len(c.buffers[id].spilled) == 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *MergeJoinCache) postJoinSchemaBuilt() bool {
@@ -796,39 +1422,46 @@ func (c *MergeJoinCache) postJoinSchemaBuilt() bool {
 }
 
 func (c *MergeJoinCache) buildPostJoinSchema() {
-	left := c.schemas[c.leftID].columns
-	right := c.schemas[c.rightID].columns
-
-	// Find column names shared between the two tables
-	shared := make(map[string]bool, len(left))
-	for _, leftColumn := range left {
-		for _, rightColumn := range right {
+	// Count the number of streams each column name appears in
+	streams := make(map[string]int)
+	ncols := 0
+	for _, id := range c.ids {
+		for _, column := range c.schemas[id].columns {
+			streams[column.Label]++
+		}
+		ncols += len(c.schemas[id].columns)
+	}
 
-			if leftColumn.Label == rightColumn.Label {
-				shared[leftColumn.Label] = true
-				break
-			}
+	// Find column names shared between the tables
+	shared := make(map[string]bool, len(streams))
+	for label, n := range streams {
+		if n > 1 {
+			shared[label] = true
 		}
 	}
 
+	// By default join on the columns that are common to every stream
 	if len(c.on) == 0 {
-		c.on = shared
+		for label, n := range streams {
+			if n == len(c.ids) {
+				c.on[label] = true
+			}
+		}
 	}
 
-	ncols := len(left) + len(right)
-
 	c.schema = schema{
-		columns: make([]flux.ColMeta, 0, ncols-len(c.on)),
-		key:     make([]flux.ColMeta, 0, ncols-len(c.on)),
+		columns: make([]flux.ColMeta, 0, ncols),
+		key:     make([]flux.ColMeta, 0, ncols),
 	}
 
-	c.colIndex = make(map[flux.ColMeta]int, ncols-len(c.on))
+	c.colIndex = make(map[flux.ColMeta]int, ncols)
 	c.schemaMap = make(map[tableCol]flux.ColMeta, ncols)
-	added := make(map[string]bool, ncols-len(c.on))
+	added := make(map[string]bool, ncols)
 
 	// Build schema for output table
-	addColumnsToSchema(c.names[c.leftID], left, added, shared, c.on, &c.schema, c.schemaMap)
-	addColumnsToSchema(c.names[c.rightID], right, added, shared, c.on, &c.schema, c.schemaMap)
+	for _, id := range c.ids {
+		addColumnsToSchema(c.names[id], c.schemas[id].columns, added, shared, c.on, &c.schema, c.schemaMap)
+	}
 
 	// Give schema an order
 	sort.Sort(c.schema)
@@ -840,7 +1473,9 @@ func (c *MergeJoinCache) buildPostJoinSchema() {
 // equalJoinKeys compares two keys for equality.
 // Null values are not considered equal when joining (unlike when grouping).
 func equalJoinkeys(left, right flux.GroupKey) bool {
-
+	if len(left.Cols()) != len(right.Cols()) {
+		return false
+	}
 	for j, v := range left.Values() {
 		// value.Equal will return false if both sides are null
 		if !v.Equal(right.Value(j)) {
@@ -850,24 +1485,31 @@ func equalJoinkeys(left, right flux.GroupKey) bool {
 	return true
 }
 
-func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
-	// Sort input tables
-	left.Sort(c.order, false)
-	right.Sort(c.order, false)
-
-	var leftSet, rightSet subset
-	var leftKey, rightKey flux.GroupKey
+// join performs a sort merge join of one table from each stream.
+// A table is only missing when performing an outer join
+// and is treated as an empty table.
+func (c *MergeJoinCache) join(tables []*execute.ColListTableBuilder) (flux.Table, error) {
+	if c.method == "asof" {
+		return c.joinAsOf(tables[0], tables[1])
+	}
 
-	leftSet, leftKey = c.advance(leftSet.Stop, left)
-	rightSet, rightKey = c.advance(rightSet.Stop, right)
+	n := len(tables)
+	sets := make([]subset, n)
+	keys := make([]flux.GroupKey, n)
+	groupKeys := make(preJoinGroupKeys, n)
 
-	keys := map[execute.DatasetID]flux.GroupKey{
-		c.leftID:  left.Key(),
-		c.rightID: right.Key(),
+	// Sort input tables
+	for i, table := range tables {
+		if table == nil {
+			continue
+		}
+		table.Sort(c.order, false)
+		sets[i], keys[i] = c.advance(0, table)
+		groupKeys[i] = table.Key()
 	}
 
 	// Instantiate a builder for the output table
-	groupKey := c.postJoinGroupKey(keys)
+	groupKey := c.postJoinGroupKey(groupKeys)
 	builder := execute.NewColListTableBuilder(groupKey, c.alloc)
 
 	for _, column := range c.schema.columns {
@@ -878,63 +1520,229 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 	}
 
 	// Perform sort merge join
-	for !leftSet.Empty() && !rightSet.Empty() {
-		if equalJoinkeys(leftKey, rightKey) {
-
-			for l := leftSet.Start; l < leftSet.Stop; l++ {
-				for r := rightSet.Start; r < rightSet.Stop; r++ {
-
-					leftRecord := left.GetRow(l)
-					rightRecord := right.GetRow(r)
-
-					leftRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.leftID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-						_ = builder.AppendValue(newColumnIdx, columnVal)
-					})
-
-					rightRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.rightID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-
-						// No need to append value if column is part of the join key.
-						// Because value already appended when iterating over left record.
-						if !c.on[newColumn.Label] {
-							_ = builder.AppendValue(newColumnIdx, columnVal)
-						}
-					})
+	present := make([]bool, n)
+	for {
+		// Find the smallest join key among the streams with rows left
+		min := -1
+		for i := range tables {
+			if sets[i].Empty() {
+				continue
+			}
+			if min < 0 || keys[i].Less(keys[min]) {
+				min = i
+			}
+		}
+		if min < 0 {
+			break
+		}
+
+		// Find the streams whose current rows have the smallest join key.
+		// Rows with a null join value never match but must still be consumed.
+		for i := range tables {
+			present[i] = i == min || (!sets[i].Empty() && equalJoinkeys(keys[i], keys[min]))
+		}
+
+		if c.complete(tables, present, keys[min]) {
+			if err := c.appendProduct(builder, tables, sets, present); err != nil {
+				return nil, err
+			}
+		}
+
+		for i, table := range tables {
+			if present[i] {
+				sets[i], keys[i] = c.advance(sets[i].Stop, table)
+			}
+		}
+	}
+
+	// The table is a copy of the rows, so the memory of the builder can be released.
+	table, err := builder.Table()
+	builder.ClearData()
+	return table, err
+}
+
+// joinAsOf matches every row of the left table with the most recent row
+// of the right table that has the same values for the join columns and
+// whose time is not after the time of the left row. Rows further apart
+// than the tolerance do not match. Left rows without a match are kept.
+func (c *MergeJoinCache) joinAsOf(left, right *execute.ColListTableBuilder) (flux.Table, error) {
+	// The time column is matched separately from the other join columns
+	exact := make(map[string]bool, len(c.on))
+	order := make([]string, 0, len(c.on))
+	for _, label := range c.order {
+		if label != execute.DefaultTimeColLabel {
+			exact[label] = true
+			order = append(order, label)
+		}
+	}
+	order = append(order, execute.DefaultTimeColLabel)
+
+	groupKeys := make(preJoinGroupKeys, 2)
+	var leftSet, rightSet subset
+	var leftKey, rightKey flux.GroupKey
+	if left != nil {
+		left.Sort(order, false)
+		leftSet, leftKey = advanceOn(0, left, exact)
+		groupKeys[0] = left.Key()
+	}
+	if right != nil {
+		right.Sort(order, false)
+		rightSet, rightKey = advanceOn(0, right, exact)
+		groupKeys[1] = right.Key()
+	}
+
+	builder := execute.NewColListTableBuilder(c.postJoinGroupKey(groupKeys), c.alloc)
+	for _, column := range c.schema.columns {
+		if _, err := builder.AddCol(column); err != nil {
+			return nil, err
+		}
+	}
+
+	records := make([]values.Object, 2)
+	for !leftSet.Empty() {
+		// Skip the right rows that cannot match any of the remaining left rows
+		for !rightSet.Empty() && !equalJoinkeys(leftKey, rightKey) && rightKey.Less(leftKey) {
+			rightSet, rightKey = advanceOn(rightSet.Stop, right, exact)
+		}
+
+		// Null times are sorted first and never match
+		var candidates subset
+		if !rightSet.Empty() && equalJoinkeys(leftKey, rightKey) {
+			candidates = rightSet
+			for candidates.Start < candidates.Stop && asOfTime(right.GetRow(candidates.Start)).IsNull() {
+				candidates.Start++
+			}
+		}
+
+		next := candidates.Start
+		for l := leftSet.Start; l < leftSet.Stop; l++ {
+			records[0], records[1] = left.GetRow(l), nil
+
+			if t := asOfTime(records[0]); !t.IsNull() {
+				// Both tables are sorted by time, so the most recent
+				// right row only ever moves forward.
+				for next < candidates.Stop && asOfTime(right.GetRow(next)).Time() <= t.Time() {
+					next++
+				}
+				if next > candidates.Start {
+					record := right.GetRow(next - 1)
+					if c.tolerance.IsZero() || asOfTime(record).Time().Add(c.tolerance) >= t.Time() {
+						records[1] = record
+					}
 				}
 			}
-			leftSet, leftKey = c.advance(leftSet.Stop, left)
-			rightSet, rightKey = c.advance(rightSet.Stop, right)
-		} else if leftKey.Less(rightKey) {
-			leftSet, leftKey = c.advance(leftSet.Stop, left)
-		} else {
-			rightSet, rightKey = c.advance(rightSet.Stop, right)
+
+			if err := c.appendRow(builder, records); err != nil {
+				return nil, err
+			}
+		}
+		leftSet, leftKey = advanceOn(leftSet.Stop, left, exact)
+	}
+
+	// The table is a copy of the rows, so the memory of the builder can be released.
+	table, err := builder.Table()
+	builder.ClearData()
+	return table, err
+}
+
+// asOfTime returns the value of the time column of a record.
+func asOfTime(record values.Object) values.Value {
+	v, ok := record.Get(execute.DefaultTimeColLabel)
+	if !ok {
+		return values.NewNull(semantic.Time)
+	}
+	return v
+}
+
+// appendProduct appends the cartesian product of the current rows of
+// the streams marked in present to builder.
+func (c *MergeJoinCache) appendProduct(builder *execute.ColListTableBuilder, tables []*execute.ColListTableBuilder, sets []subset, present []bool) error {
+	records := make([]values.Object, len(tables))
+
+	var product func(i int) error
+	product = func(i int) error {
+		if i == len(tables) {
+			return c.appendRow(builder, records)
+		}
+		if !present[i] {
+			records[i] = nil
+			return product(i + 1)
+		}
+		for r := sets[i].Start; r < sets[i].Stop; r++ {
+			records[i] = tables[i].GetRow(r)
+			if err := product(i + 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return product(0)
+}
+
+// appendRow appends a single output row to builder.
+// A record is nil when the row has no match in the corresponding stream.
+// The columns from a missing stream are then filled with nulls,
+// except for group key columns which take their value from the group key.
+func (c *MergeJoinCache) appendRow(builder *execute.ColListTableBuilder, records []values.Object) error {
+	row := make([]values.Value, len(c.schema.columns))
+	for i, record := range records {
+		c.fillRow(row, c.ids[i], record)
+	}
+
+	key := builder.Key()
+	for j, v := range row {
+		if v == nil {
+			if idx := execute.ColIdx(c.schema.columns[j].Label, key.Cols()); idx >= 0 {
+				v = key.Value(idx)
+			}
+		}
+		if v == nil {
+			if err := builder.AppendNil(j); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := builder.AppendValue(j, v); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	return builder.Table()
+// fillRow sets the values of record in the output row.
+// Join columns are shared between the streams so the first value set wins.
+func (c *MergeJoinCache) fillRow(row []values.Value, id execute.DatasetID, record values.Object) {
+	if record == nil {
+		return
+	}
+	record.Range(func(columnName string, columnVal values.Value) {
+		column := tableCol{
+			table: c.names[id],
+			col:   columnName,
+		}
+		newColumn := c.schemaMap[column]
+		newColumnIdx := c.colIndex[newColumn]
+		if row[newColumnIdx] == nil {
+			row[newColumnIdx] = columnVal
+		}
+	})
 }
 
-// postJoinGroupKey produces a new group key value from a left and a right group key value
-func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupKey) flux.GroupKey {
+// postJoinGroupKey produces a new group key value from the pre-join group keys.
+// The key of a stream that is missing contributes its group key columns with null values.
+func (c *MergeJoinCache) postJoinGroupKey(keys preJoinGroupKeys) flux.GroupKey {
 	key := groupKey{
 		cols: make([]flux.ColMeta, 0, len(keys)*5),
 		vals: make([]values.Value, 0, len(keys)*5),
 	}
 
-	added := make(map[string]bool, len(keys)*5)
+	added := make(map[string]int, len(keys)*5)
 
-	for id, groupKey := range keys {
+	for i, id := range c.ids {
+		groupKey := keys[i]
+		if groupKey == nil {
+			groupKey = nullGroupKey(c.schemas[id].key)
+		}
 		for j, column := range groupKey.Cols() {
 
 			tableAndColumn := tableCol{
@@ -944,12 +1752,15 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 
 			colMeta := c.schemaMap[tableAndColumn]
 
-			if !added[colMeta.Label] {
+			if idx, ok := added[colMeta.Label]; !ok {
+				added[colMeta.Label] = len(key.cols)
 				key.cols = append(key.cols, colMeta)
 				key.vals = append(key.vals, groupKey.Value(j))
+			} else if key.vals[idx].IsNull() {
+				// A shared column is null for a stream of an outer
+				// join that has no matching table, so prefer a real value.
+				key.vals[idx] = groupKey.Value(j)
 			}
-
-			added[colMeta.Label] = true
 		}
 	}
 
@@ -961,18 +1772,26 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 
 // advance advances the row pointer of a sorted table that is being joined
 func (c *MergeJoinCache) advance(offset int, table *execute.ColListTableBuilder) (subset, flux.GroupKey) {
+	return advanceOn(offset, table, c.on)
+}
+
+// advanceOn advances the row pointer of a table sorted on the given columns
+func advanceOn(offset int, table *execute.ColListTableBuilder, on map[string]bool) (subset, flux.GroupKey) {
 	// TODO(jlapacik): this is a temporary hack
 	// remove when ColListTableBuilder implements ColReader
 	tbl, _ := table.Table()
+	// Release the copy once the rows have been compared.
+	tbl.RefCount(1)
+	defer tbl.RefCount(-1)
 	cr := tbl.(flux.ColReader)
 	if n := cr.Len(); n == offset {
 		return subset{Start: n, Stop: n}, nil
 	}
 	start := offset
-	key := execute.GroupKeyForRowOn(start, cr, c.on)
+	key := execute.GroupKeyForRowOn(start, cr, on)
 	sequence := subset{Start: start}
 	offset++
-	for offset < cr.Len() && equalRowKeys(start, offset, cr, c.on) {
+	for offset < cr.Len() && equalRowKeys(start, offset, cr, on) {
 		offset++
 	}
 	sequence.Stop = offset
@@ -36,7 +36,7 @@ func TestJoin_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -57,7 +57,7 @@ func TestJoin_NewQuery(t *testing.T) {
 						ID: "range3",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -104,7 +104,7 @@ func TestJoin_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -125,7 +125,7 @@ func TestJoin_NewQuery(t *testing.T) {
 						ID: "range3",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -153,6 +153,90 @@ func TestJoin_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "as-of join",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["_time", "host"], method: "asof", tolerance: 10s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbA",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "from2",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbB",
+						},
+					},
+					{
+						ID: "range3",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "join4",
+						Spec: &universe.JoinOpSpec{
+							On:         []string{"_time", "host"},
+							TableNames: map[flux.OperationID]string{"range1": "a", "range3": "b"},
+							Method:     "asof",
+							Tolerance:  flux.ConvertDuration(10 * time.Second),
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "from2", Child: "range3"},
+					{Parent: "range1", Child: "join4"},
+					{Parent: "range3", Child: "join4"},
+				},
+			},
+		},
+		{
+			Name: "as-of join without time",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["host"], method: "asof")`,
+			WantErr: true,
+		},
+		{
+			Name: "tolerance without as-of join",
+			Raw: `
+				a = from(bucket:"dbA") |> range(start:-1h)
+				b = from(bucket:"dbB") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["_time"], tolerance: 10s)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -1505,10 +1589,11 @@ func TestMergeJoin_Process(t *testing.T) {
 			},
 		},
 		{
-			name: "two failures",
+			name: "left outer",
 			spec: &universe.MergeJoinProcedureSpec{
 				On:         []string{"_time"},
 				TableNames: tableNames,
+				Method:     "left",
 			},
 			data0: []*executetest.Table{
 				{
@@ -1516,7 +1601,11 @@ func TestMergeJoin_Process(t *testing.T) {
 						{Label: "_time", Type: flux.TTime},
 						{Label: "_value", Type: flux.TFloat},
 					},
-					Err: errors.New("expected error"),
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
 				},
 			},
 			data1: []*executetest.Table{
@@ -1525,55 +1614,941 @@ func TestMergeJoin_Process(t *testing.T) {
 						{Label: "_time", Type: flux.TTime},
 						{Label: "_value", Type: flux.TFloat},
 					},
-					Err: errors.New("expected error"),
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(2), 2.0, nil},
+						{execute.Time(3), 3.0, 30.0},
+					},
 				},
 			},
 		},
-	}
-	for _, tc := range testCases {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			if tc.skip {
-				t.Skip()
-			}
-
-			id0 := executetest.RandomDatasetID()
-			id1 := executetest.RandomDatasetID()
-
-			parents := []execute.DatasetID{
-				execute.DatasetID(id0),
-				execute.DatasetID(id1),
-			}
-
-			tableNames := make(map[execute.DatasetID]string, len(tc.spec.TableNames))
-			for i, name := range tc.spec.TableNames {
-				tableNames[parents[i]] = name
-			}
-
-			d := executetest.NewDataset(executetest.RandomDatasetID())
-			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On)
-			c.SetTriggerSpec(plan.DefaultTriggerSpec)
-			jt := universe.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
-
-			l := len(tc.data0)
-			if len(tc.data1) > l {
-				l = len(tc.data1)
-			}
-			var err error
-			for i := 0; i < l; i++ {
-				if i < len(tc.data0) {
-					if err = jt.Process(parents[0], tc.data0[i]); err != nil {
-						break
-					}
-				}
-				if i < len(tc.data1) {
-					if err = jt.Process(parents[1], tc.data1[i]); err != nil {
-						break
-					}
-				}
-			}
-			jt.Finish(parents[0], err)
-			jt.Finish(parents[1], err)
+		{
+			name: "right outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "right",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(3), 3.0},
+						{execute.Time(4), 4.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(2), nil, 20.0},
+						{execute.Time(3), 3.0, 30.0},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{nil, 5.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{nil, 5.0, nil},
+						{execute.Time(1), 1.0, nil},
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), nil, 30.0},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer with unmatched table",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "tag"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 3.0, "b"},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "a"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, "a"},
+						{execute.Time(2), 2.0, nil, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 3.0, nil, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer with several matching tables",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "x"},
+						{execute.Time(2), "y"},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "y"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "x", "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), "y", "y"},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer with unmatched rows in several tables",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "x"},
+						{execute.Time(2), 20.0, "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(4), 40.0, "y"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, "x"},
+						{execute.Time(2), nil, 20.0, "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(4), nil, 40.0, "y"},
+					},
+				},
+				{
+					KeyCols:   []string{"host"},
+					KeyValues: []interface{}{nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 3.0, nil, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "as-of",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "asof",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(5), 5.0},
+						{execute.Time(10), 10.0},
+						{execute.Time(0), 0.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(4), 40.0},
+						{execute.Time(1), 10.0},
+						{execute.Time(6), 60.0},
+						{nil, 100.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), 0.0, nil},
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(5), 5.0, 40.0},
+						{execute.Time(10), 10.0, 60.0},
+					},
+				},
+			},
+		},
+		{
+			name: "as-of with tolerance",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "host"},
+				TableNames: tableNames,
+				Method:     "asof",
+				Tolerance:  flux.ConvertDuration(2),
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 1.0, "A"},
+						{execute.Time(3), 2.0, "B"},
+						{execute.Time(10), 3.0, "A"},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 10.0, "A"},
+						{execute.Time(5), 20.0, "A"},
+						{execute.Time(4), 30.0, "B"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 1.0, 10.0, "A"},
+						{execute.Time(10), 3.0, nil, "A"},
+						{execute.Time(3), 2.0, nil, "B"},
+					},
+				},
+			},
+		},
+		{
+			name: "two failures",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Err: errors.New("expected error"),
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Err: errors.New("expected error"),
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.skip {
+				t.Skip()
+			}
+
+			id0 := executetest.RandomDatasetID()
+			id1 := executetest.RandomDatasetID()
+
+			parents := []execute.DatasetID{
+				execute.DatasetID(id0),
+				execute.DatasetID(id1),
+			}
+
+			tableNames := make(map[execute.DatasetID]string, len(tc.spec.TableNames))
+			for i, name := range tc.spec.TableNames {
+				tableNames[parents[i]] = name
+			}
+
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On, tc.spec.Method, tc.spec.Tolerance)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			jt := universe.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
+
+			l := len(tc.data0)
+			if len(tc.data1) > l {
+				l = len(tc.data1)
+			}
+			var err error
+			for i := 0; i < l; i++ {
+				if i < len(tc.data0) {
+					if err = jt.Process(parents[0], tc.data0[i]); err != nil {
+						break
+					}
+				}
+				if i < len(tc.data1) {
+					if err = jt.Process(parents[1], tc.data1[i]); err != nil {
+						break
+					}
+				}
+			}
+			jt.Finish(parents[0], err)
+			jt.Finish(parents[1], err)
+
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+
+			sort.Sort(executetest.SortedTables(got))
+			sort.Sort(executetest.SortedTables(tc.want))
+
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestMergeJoin_ProcessMultipleStreams(t *testing.T) {
+	tableNames := []string{"a", "b", "c"}
+
+	testCases := []struct {
+		name string
+		spec *universe.MergeJoinProcedureSpec
+		data [][]*executetest.Table // data from each parent
+		want []*executetest.Table
+	}{
+		{
+			name: "inner",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+			},
+			data: [][]*executetest.Table{
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 1.0},
+							{execute.Time(2), 2.0},
+							{execute.Time(3), 3.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 10.0},
+							{execute.Time(2), 20.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "unit", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(2), 200.0, "ms"},
+							{execute.Time(3), 300.0, "ms"},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+						{Label: "unit", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0, 200.0, "ms"},
+					},
+				},
+			},
+		},
+		{
+			name: "inner with group keys",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "host"},
+				TableNames: tableNames,
+			},
+			data: [][]*executetest.Table{
+				{
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 1.0, "A"},
+						},
+					},
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 2.0, "B"},
+						},
+					},
+				},
+				{
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 10.0, "A"},
+						},
+					},
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 20.0, "B"},
+						},
+					},
+				},
+				{
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 200.0, "B"},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.0, 20.0, 200.0, "B"},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data: [][]*executetest.Table{
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 1.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(2), 20.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 100.0},
+							{execute.Time(3), 300.0},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil, 100.0},
+						{execute.Time(2), nil, 20.0, nil},
+						{execute.Time(3), nil, nil, 300.0},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data: [][]*executetest.Table{
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 1.0},
+							{execute.Time(2), 2.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(2), 20.0},
+							{execute.Time(3), 30.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 100.0},
+							{execute.Time(3), 300.0},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil, 100.0},
+						{execute.Time(2), 2.0, 20.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer with several matching tables",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data: [][]*executetest.Table{
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 1.0},
+							{execute.Time(2), 2.0},
+							{execute.Time(3), 3.0},
+						},
+					},
+				},
+				{
+					{
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 10.0},
+							{execute.Time(2), 20.0},
+						},
+					},
+				},
+				{
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(1), 100.0, "x"},
+						},
+					},
+					{
+						KeyCols: []string{"host"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_value", Type: flux.TFloat},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{execute.Time(2), 200.0, "y"},
+						},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, 100.0, "x"},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0, 200.0, "y"},
+					},
+				},
+				{
+					KeyCols:   []string{"host"},
+					KeyValues: []interface{}{nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "_value_c", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 3.0, nil, nil, nil},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			parents := make([]execute.DatasetID, len(tc.data))
+			tableNames := make(map[execute.DatasetID]string, len(tc.spec.TableNames))
+			for i, name := range tc.spec.TableNames {
+				parents[i] = executetest.RandomDatasetID()
+				tableNames[parents[i]] = name
+			}
+
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On, tc.spec.Method, tc.spec.Tolerance)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			jt := universe.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
+
+			for i, data := range tc.data {
+				for _, tbl := range data {
+					if err := jt.Process(parents[i], tbl); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			for _, id := range parents {
+				jt.Finish(id, nil)
+			}
 
 			got, err := executetest.TablesFromCache(c)
 			if err != nil {
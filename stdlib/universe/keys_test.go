@@ -29,7 +29,7 @@ func TestKeys_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop:        flux.Now,
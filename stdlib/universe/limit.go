@@ -33,6 +33,7 @@ func init() {
 	plan.RegisterProcedureSpec(LimitKind, newLimitProcedure, LimitKind)
 	// TODO register a range transformation. Currently range is only supported if it is pushed down into a select procedure.
 	execute.RegisterTransformation(LimitKind, createLimitTransformation)
+	plan.RegisterPhysicalRules(PushDownLimitRule{})
 }
 
 func createLimitOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -66,11 +67,26 @@ func (s *LimitOpSpec) Kind() flux.OperationKind {
 }
 
 type LimitProcedureSpec struct {
-	plan.DefaultCost
 	N      int64 `json:"n"`
 	Offset int64 `json:"offset"`
 }
 
+// Cost estimates that the limit reads and keeps at most N rows of each table.
+func (s *LimitProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	read, out := in.Cardinality, in.Cardinality
+	if n := (s.N + s.Offset) * in.GroupCardinality; n < read {
+		read = n
+	}
+	if n := s.N * in.GroupCardinality; n < out {
+		out = n
+	}
+	return plan.Cost{CPU: read}, plan.Statistics{
+		Cardinality:      out,
+		GroupCardinality: in.GroupCardinality,
+	}
+}
+
 func newLimitProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	spec, ok := qs.(*LimitOpSpec)
 	if !ok {
@@ -96,6 +112,45 @@ func (s *LimitProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
 }
 
+// PushDownLimitRule pushes a limit into a source that can produce
+// only the limited rows of each of its tables.
+//
+//     limit
+//       |     ==>   source (limit)
+//     source
+type PushDownLimitRule struct{}
+
+func (PushDownLimitRule) Name() string {
+	return "PushDownLimitRule"
+}
+
+func (PushDownLimitRule) Pattern() plan.Pattern {
+	return plan.Pat(LimitKind, plan.Any())
+}
+
+func (PushDownLimitRule) Rewrite(limitNode plan.Node) (plan.Node, bool, error) {
+	sourceNode := limitNode.Predecessors()[0]
+	if len(sourceNode.Predecessors()) != 0 {
+		return limitNode, false, nil
+	}
+	sourceSpec, ok := sourceNode.ProcedureSpec().(plan.LimitSource)
+	if !ok {
+		return limitNode, false, nil
+	}
+	limitSpec := limitNode.ProcedureSpec().(*LimitProcedureSpec)
+	if limitSpec.N <= 0 {
+		return limitNode, false, nil
+	}
+	newSpec, ok := sourceSpec.PushDownLimit(limitSpec.N, limitSpec.Offset)
+	if !ok {
+		return limitNode, false, nil
+	}
+	if err := sourceNode.ReplaceSpec(newSpec); err != nil {
+		return nil, false, err
+	}
+	return sourceNode, true, nil
+}
+
 func createLimitTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*LimitProcedureSpec)
 	if !ok {
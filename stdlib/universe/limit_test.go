@@ -6,7 +6,11 @@ import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/generate"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 )
 
@@ -236,3 +240,86 @@ func TestLimit_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestPushDownLimitRule(t *testing.T) {
+	var (
+		from  = &influxdb.FromProcedureSpec{Bucket: "telegraf"}
+		limit = &universe.LimitProcedureSpec{N: 10, Offset: 5}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "limit",
+			Rules: []plan.Rule{universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("limit", limit),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "telegraf", Limit: 10, Offset: 5}),
+				},
+			},
+		},
+		{
+			Name:  "zero rows",
+			Rules: []plan.Rule{universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 0}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "source rejects limit",
+			Rules: []plan.Rule{universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "telegraf", Limit: 1}),
+					plan.CreatePhysicalNode("limit", limit),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "not a limit source",
+			Rules: []plan.Rule{universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("generate", &generate.FromGeneratorProcedureSpec{Count: 10}),
+					plan.CreatePhysicalNode("limit", limit),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "rows used twice",
+			Rules: []plan.Rule{universe.PushDownLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("limit", limit),
+					plan.CreatePhysicalNode("count", &universe.CountProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
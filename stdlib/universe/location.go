@@ -0,0 +1,68 @@
+package universe
+
+import (
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const (
+	FixedZoneKind    = "fixedZone"
+	LoadLocationKind = "loadLocation"
+)
+
+func init() {
+	fixedZonePolyType := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"offset": semantic.Duration,
+		},
+		Return: flux.LocationPolyType,
+	})
+	flux.RegisterPackageValue("universe", FixedZoneKind, values.NewFunction(FixedZoneKind, fixedZonePolyType, fixedZone, false))
+
+	loadLocationPolyType := semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"name": semantic.String,
+		},
+		Required: semantic.LabelSet{"name"},
+		Return:   flux.LocationPolyType,
+	})
+	flux.RegisterPackageValue("universe", LoadLocationKind, values.NewFunction(LoadLocationKind, loadLocationPolyType, loadLocation, false))
+}
+
+// fixedZone returns a location that is always offset from UTC by the same duration.
+func fixedZone(args values.Object) (values.Value, error) {
+	return interpreter.DoFunctionCall(func(args interpreter.Arguments) (values.Value, error) {
+		offset, _, err := flux.Arguments{Arguments: args}.GetDuration("offset")
+		if err != nil {
+			return nil, err
+		}
+		if offset.Months() != 0 {
+			return nil, errors.Errorf("fixedZone offset %v must not have a month component", offset)
+		}
+		l, err := flux.FixedZone(time.Duration(offset.Nanoseconds()))
+		if err != nil {
+			return nil, err
+		}
+		return l.Value(), nil
+	}, args)
+}
+
+// loadLocation returns the location with a name from the IANA Time Zone database.
+func loadLocation(args values.Object) (values.Value, error) {
+	return interpreter.DoFunctionCall(func(args interpreter.Arguments) (values.Value, error) {
+		name, err := args.GetRequiredString("name")
+		if err != nil {
+			return nil, err
+		}
+		l, err := flux.LoadLocation(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load location %q", name)
+		}
+		return l.Value(), nil
+	}, args)
+}
@@ -137,8 +137,21 @@ func createPivotTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	}
 
 	cache := execute.NewTableBuilderCache(a.Allocator())
-	d := execute.NewDataset(id, mode, cache)
+	spiller := execute.NewSpiller(execute.GetSpillConfig(a.Dependencies()), a.Allocator())
+	if !spiller.Enabled() {
+		d := execute.NewDataset(id, mode, cache)
+		t := NewPivotTransformation(d, cache, s)
+		return t, d, nil
+	}
+	spill := &pivotSpill{
+		DataCache: cache,
+		spiller:   spiller,
+		groups:    execute.NewGroupLookup(),
+	}
+	d := execute.NewDataset(id, mode, spill)
 	t := NewPivotTransformation(d, cache, s)
+	t.spill = spill
+	spill.t = t
 	return t, d, nil
 }
 
@@ -155,6 +168,9 @@ type pivotTransformation struct {
 	colKeyMaps map[string]map[string]int
 	rowKeyMaps map[string]map[string]int
 	nextRowCol map[string]rowCol
+	// spill holds the tables that were written to disk.
+	// It is nil when spilling to disk is disabled.
+	spill *pivotSpill
 }
 
 func NewPivotTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *PivotProcedureSpec) *pivotTransformation {
@@ -173,12 +189,46 @@ func (t *pivotTransformation) RetractTable(id execute.DatasetID, key flux.GroupK
 	return t.d.RetractTable(key)
 }
 
+// pivotInput describes how the columns of an input table are pivoted.
+type pivotInput struct {
+	rowKeyIndex   map[string]int
+	colKeyIndex   map[string]int
+	valueColIndex int
+	valueColType  flux.ColType
+	// cols are the columns copied over to the output table.
+	cols []flux.ColMeta
+	// colMap maps the copied columns to the columns of the input table.
+	colMap []int
+	// key is the group key of the output table.
+	key flux.GroupKey
+}
+
 func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	in, err := t.newPivotInput(tbl)
+	if err != nil {
+		return err
+	}
+	if t.spill != nil && t.spill.suspended(in.key) {
+		return t.spill.add(in.key, tbl)
+	}
+	builder, err := t.tableBuilder(in)
+	if err != nil {
+		return err
+	}
+	if t.spill != nil {
+		return t.spill.process(in, tbl, builder)
+	}
+	return tbl.Do(func(cr flux.ColReader) error {
+		return t.processRows(in, builder, cr)
+	})
+}
+
+func (t *pivotTransformation) newPivotInput(tbl flux.Table) (*pivotInput, error) {
 	rowKeyIndex := make(map[string]int)
 	for _, v := range t.spec.RowKey {
 		idx := execute.ColIdx(v, tbl.Cols())
 		if idx < 0 {
-			return fmt.Errorf("specified column does not exist in table: %v", v)
+			return nil, fmt.Errorf("specified column does not exist in table: %v", v)
 		}
 		rowKeyIndex[v] = idx
 	}
@@ -223,94 +273,110 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 
 	for k, v := range colKeyIndex {
 		if v < 0 {
-			return fmt.Errorf("specified column does not exist in table: %v", k)
+			return nil, fmt.Errorf("specified column does not exist in table: %v", k)
 		}
 	}
 
-	newGroupKey := execute.NewGroupKey(keyCols, keyValues)
-	builder, created := t.cache.TableBuilder(newGroupKey)
-	groupKeyString := newGroupKey.String()
+	return &pivotInput{
+		rowKeyIndex:   rowKeyIndex,
+		colKeyIndex:   colKeyIndex,
+		valueColIndex: valueColIndex,
+		valueColType:  valueColType,
+		cols:          cols,
+		colMap:        colMap,
+		key:           execute.NewGroupKey(keyCols, keyValues),
+	}, nil
+}
+
+// tableBuilder returns the builder of the output table for the input,
+// creating the builder and its lookup maps for a new output table.
+func (t *pivotTransformation) tableBuilder(in *pivotInput) (execute.TableBuilder, error) {
+	builder, created := t.cache.TableBuilder(in.key)
 	if created {
-		for _, c := range cols {
+		for _, c := range in.cols {
 			_, err := builder.AddCol(c)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 		}
+		groupKeyString := in.key.String()
 		t.colKeyMaps[groupKeyString] = make(map[string]int)
 		t.rowKeyMaps[groupKeyString] = make(map[string]int)
-		t.nextRowCol[groupKeyString] = rowCol{nextCol: len(cols), nextRow: 0}
+		t.nextRowCol[groupKeyString] = rowCol{nextCol: len(in.cols), nextRow: 0}
 	}
+	return builder, nil
+}
 
-	return tbl.Do(func(cr flux.ColReader) error {
-		for row := 0; row < cr.Len(); row++ {
-			rowKey := ""
-			colKey := ""
-			for _, rk := range t.spec.RowKey {
-				j := rowKeyIndex[rk]
-				c := cr.Cols()[j]
-				rowKey += valueToStr(cr, c, row, j)
-			}
+// processRows pivots the rows of cr into the builder of the output table.
+func (t *pivotTransformation) processRows(in *pivotInput, builder execute.TableBuilder, cr flux.ColReader) error {
+	groupKeyString := in.key.String()
+	for row := 0; row < cr.Len(); row++ {
+		rowKey := ""
+		colKey := ""
+		for _, rk := range t.spec.RowKey {
+			j := in.rowKeyIndex[rk]
+			c := cr.Cols()[j]
+			rowKey += valueToStr(cr, c, row, j)
+		}
 
-			for _, ck := range t.spec.ColumnKey {
-				j := colKeyIndex[ck]
-				c := cr.Cols()[j]
-				if colKey == "" {
-					colKey = valueToStr(cr, c, row, j)
-				} else {
-					colKey = colKey + "_" + valueToStr(cr, c, row, j)
-				}
+		for _, ck := range t.spec.ColumnKey {
+			j := in.colKeyIndex[ck]
+			c := cr.Cols()[j]
+			if colKey == "" {
+				colKey = valueToStr(cr, c, row, j)
+			} else {
+				colKey = colKey + "_" + valueToStr(cr, c, row, j)
 			}
+		}
 
-			// we have columns for the copy-over in place;
-			// we know the row key;
-			// we know the col key;
-			//  0.  If we've not seen the colKey before, then we need to add a new column and backfill it.
-			if _, ok := t.colKeyMaps[groupKeyString][colKey]; !ok {
-				newCol := flux.ColMeta{
-					Label: colKey,
-					Type:  valueColType,
-				}
-				nextCol, err := builder.AddCol(newCol)
-				if err != nil {
+		// we have columns for the copy-over in place;
+		// we know the row key;
+		// we know the col key;
+		//  0.  If we've not seen the colKey before, then we need to add a new column and backfill it.
+		if _, ok := t.colKeyMaps[groupKeyString][colKey]; !ok {
+			newCol := flux.ColMeta{
+				Label: colKey,
+				Type:  in.valueColType,
+			}
+			nextCol, err := builder.AddCol(newCol)
+			if err != nil {
+				return err
+			}
+			t.colKeyMaps[groupKeyString][colKey] = nextCol
+		}
+		//  1.  if we've not seen rowKey before, then we need to append a new row, with copied values for the
+		//  existing columns, as well as zero values for the pivoted columns.
+		if _, ok := t.rowKeyMaps[groupKeyString][rowKey]; !ok {
+			// rowkey U groupKey cols
+			for cidx := range in.cols {
+				if err := builder.AppendValue(cidx, execute.ValueForRow(cr, row, in.colMap[cidx])); err != nil {
 					return err
 				}
-				t.colKeyMaps[groupKeyString][colKey] = nextCol
 			}
-			//  1.  if we've not seen rowKey before, then we need to append a new row, with copied values for the
-			//  existing columns, as well as zero values for the pivoted columns.
-			if _, ok := t.rowKeyMaps[groupKeyString][rowKey]; !ok {
-				// rowkey U groupKey cols
-				for cidx := range cols {
-					if err := builder.AppendValue(cidx, execute.ValueForRow(cr, row, colMap[cidx])); err != nil {
-						return err
-					}
-				}
 
-				// zero-out the known key columns we've already discovered.
-				for _, v := range t.colKeyMaps[groupKeyString] {
-					if err := growColumn(builder, valueColType, v, 1); err != nil {
-						return err
-					}
+			// zero-out the known key columns we've already discovered.
+			for _, v := range t.colKeyMaps[groupKeyString] {
+				if err := growColumn(builder, in.valueColType, v, 1); err != nil {
+					return err
 				}
-				nextRowCol := t.nextRowCol[groupKeyString]
-				t.rowKeyMaps[groupKeyString][rowKey] = nextRowCol.nextRow
-				nextRowCol.nextRow++
-				t.nextRowCol[groupKeyString] = nextRowCol
-			}
-
-			// at this point, we've created, added and back-filled all the columns we know about
-			// if we found a new row key, we added a new row with zeroes set for all the value columns
-			// so in all cases we know the row exists, and the column exists.  we need to grab the
-			// value from valueCol and assign it to its pivoted position.
-			if err := builder.SetValue(t.rowKeyMaps[groupKeyString][rowKey], t.colKeyMaps[groupKeyString][colKey], execute.ValueForRow(cr, row, valueColIndex)); err != nil {
-				return err
 			}
+			nextRowCol := t.nextRowCol[groupKeyString]
+			t.rowKeyMaps[groupKeyString][rowKey] = nextRowCol.nextRow
+			nextRowCol.nextRow++
+			t.nextRowCol[groupKeyString] = nextRowCol
+		}
 
+		// at this point, we've created, added and back-filled all the columns we know about
+		// if we found a new row key, we added a new row with zeroes set for all the value columns
+		// so in all cases we know the row exists, and the column exists.  we need to grab the
+		// value from valueCol and assign it to its pivoted position.
+		if err := builder.SetValue(t.rowKeyMaps[groupKeyString][rowKey], t.colKeyMaps[groupKeyString][colKey], execute.ValueForRow(cr, row, in.valueColIndex)); err != nil {
+			return err
 		}
-		return nil
-	})
+
+	}
+	return nil
 }
 
 func growColumn(builder execute.TableBuilder, colType flux.ColType, colIdx, nRows int) error {
@@ -379,4 +445,180 @@ func (t *pivotTransformation) UpdateProcessingTime(id execute.DatasetID, pt exec
 func (t *pivotTransformation) Finish(id execute.DatasetID, err error) {
 
 	t.d.Finish(err)
+	if t.spill != nil {
+		t.spill.spiller.Close()
+	}
+}
+
+// pivotSpill is the data cache of a pivot transformation that writes
+// output tables to disk when the memory limit of the query is reached.
+//
+// When memory runs low, the rows pivoted so far for every output table are written to disk
+// and the output tables are suspended. The input tables of a suspended output table are
+// written to disk as they arrive, and are pivoted once the output table is read.
+type pivotSpill struct {
+	execute.DataCache
+	t       *pivotTransformation
+	spiller *execute.Spiller
+	// groups holds the spilled data of each suspended output table.
+	groups *execute.GroupLookup
+}
+
+// pivotGroup is the spilled data of an output table.
+type pivotGroup struct {
+	// partial holds the rows that were pivoted before the table was suspended.
+	partial flux.Table
+	// inputs holds the input tables that have not been pivoted.
+	inputs []flux.Table
+}
+
+func (s *pivotSpill) suspended(key flux.GroupKey) bool {
+	_, ok := s.groups.Lookup(key)
+	return ok
+}
+
+// add writes an input table of a suspended output table to disk.
+func (s *pivotSpill) add(key flux.GroupKey, tbl flux.Table) error {
+	in, err := s.spiller.Spill(tbl)
+	if err != nil {
+		return err
+	}
+	g, _ := s.groups.Lookup(key)
+	g.(*pivotGroup).inputs = append(g.(*pivotGroup).inputs, in)
+	return nil
+}
+
+// process pivots the table into the builder until memory runs low.
+// The remaining rows are written to disk once the output table has been suspended.
+func (s *pivotSpill) process(in *pivotInput, tbl flux.Table, builder execute.TableBuilder) error {
+	var w *execute.SpillWriter
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if w == nil && s.spiller.ShouldSpill(execute.AppendSize(builder, cr)) {
+			if err := s.suspendAll(); err != nil {
+				return err
+			}
+			var err error
+			if w, err = s.spiller.NewWriter(tbl.Key(), tbl.Cols()); err != nil {
+				return err
+			}
+		}
+		if w != nil {
+			return w.Write(cr)
+		}
+		return s.t.processRows(in, builder, cr)
+	}); err != nil {
+		if w != nil {
+			w.Close()
+		}
+		return err
+	}
+	if w == nil {
+		return nil
+	}
+	rest, err := w.Table()
+	if err != nil {
+		return err
+	}
+	g, _ := s.groups.Lookup(in.key)
+	g.(*pivotGroup).inputs = append(g.(*pivotGroup).inputs, rest)
+	return nil
+}
+
+// suspendAll writes the pivoted rows of every output table in memory to disk.
+func (s *pivotSpill) suspendAll() (err error) {
+	s.t.cache.ForEachBuilder(func(key flux.GroupKey, builder execute.TableBuilder) {
+		if err != nil || s.suspended(key) {
+			return
+		}
+		g := new(pivotGroup)
+		if builder.NRows() > 0 {
+			if g.partial, err = s.spiller.SpillBuilder(builder); err != nil {
+				return
+			}
+			builder.ClearData()
+		}
+		// The pivoted columns are kept by the builder,
+		// but the rows must be found again once the table is resumed.
+		groupKeyString := key.String()
+		s.t.rowKeyMaps[groupKeyString] = make(map[string]int)
+		nextRowCol := s.t.nextRowCol[groupKeyString]
+		nextRowCol.nextRow = 0
+		s.t.nextRowCol[groupKeyString] = nextRowCol
+		s.groups.Set(key, g)
+	})
+	return err
+}
+
+// resume reads the spilled data of a suspended output table back into its builder
+// and pivots the input tables that were written to disk.
+func (s *pivotSpill) resume(key flux.GroupKey) error {
+	v, ok := s.groups.Delete(key)
+	if !ok {
+		return nil
+	}
+	g := v.(*pivotGroup)
+	builder, _ := s.t.cache.TableBuilder(key)
+	if g.partial != nil {
+		if err := s.restore(key, g.partial, builder); err != nil {
+			return err
+		}
+	}
+	for _, tbl := range g.inputs {
+		in, err := s.t.newPivotInput(tbl)
+		if err != nil {
+			return err
+		}
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			return s.t.processRows(in, builder, cr)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restore appends the partially pivoted rows to the builder and rebuilds the lookup of their row keys.
+func (s *pivotSpill) restore(key flux.GroupKey, partial flux.Table, builder execute.TableBuilder) error {
+	groupKeyString := key.String()
+	rowKeys := s.t.rowKeyMaps[groupKeyString]
+	nextRowCol := s.t.nextRowCol[groupKeyString]
+	if err := partial.Do(func(cr flux.ColReader) error {
+		for row := 0; row < cr.Len(); row++ {
+			rowKey := ""
+			for _, rk := range s.t.spec.RowKey {
+				j := execute.ColIdx(rk, cr.Cols())
+				rowKey += valueToStr(cr, cr.Cols()[j], row, j)
+			}
+			rowKeys[rowKey] = nextRowCol.nextRow
+			nextRowCol.nextRow++
+		}
+		return execute.AppendCols(cr, builder)
+	}); err != nil {
+		return err
+	}
+	s.t.nextRowCol[groupKeyString] = nextRowCol
+	return nil
+}
+
+// Table returns the output table for the key, pivoting its spilled data first if it was suspended.
+// The table is spilled instead of copied when there is not enough memory left to copy it.
+func (s *pivotSpill) Table(key flux.GroupKey) (flux.Table, error) {
+	if err := s.resume(key); err != nil {
+		return nil, err
+	}
+	builder, _ := s.t.cache.TableBuilder(key)
+	if s.spiller.ShouldSpill(execute.TableSize(builder.NRows(), builder.Cols())) {
+		return s.spiller.SpillBuilder(builder)
+	}
+	return s.DataCache.Table(key)
+}
+
+func (s *pivotSpill) DiscardTable(key flux.GroupKey) {
+	s.groups.Delete(key)
+	s.DataCache.DiscardTable(key)
+}
+
+func (s *pivotSpill) ExpireTable(key flux.GroupKey) {
+	s.groups.Delete(key)
+	s.DataCache.ExpireTable(key)
 }
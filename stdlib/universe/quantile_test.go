@@ -32,7 +32,7 @@ func TestQuantile_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -74,7 +74,7 @@ func TestQuantile_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -115,7 +115,7 @@ func TestQuantile_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -156,7 +156,7 @@ func TestQuantile_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
@@ -199,7 +199,7 @@ func TestQuantile_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
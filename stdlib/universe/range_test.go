@@ -32,11 +32,11 @@ func TestRange_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -4 * time.Hour,
+								Relative:   flux.ConvertDuration(-4 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
-								Relative:   -2 * time.Hour,
+								Relative:   flux.ConvertDuration(-2 * time.Hour),
 								IsRelative: true,
 							},
 							TimeColumn:  "_time",
@@ -72,11 +72,11 @@ func TestRange_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -4 * time.Hour,
+								Relative:   flux.ConvertDuration(-4 * time.Hour),
 								IsRelative: true,
 							},
 							Stop: flux.Time{
-								Relative:   -2 * time.Hour,
+								Relative:   flux.ConvertDuration(-2 * time.Hour),
 								IsRelative: true,
 							},
 							TimeColumn:  "_start",
@@ -113,7 +113,7 @@ func TestRangeOperation_Marshaling(t *testing.T) {
 		ID: "range",
 		Spec: &universe.RangeOpSpec{
 			Start: flux.Time{
-				Relative:   -1 * time.Hour,
+				Relative:   flux.ConvertDuration(-1 * time.Hour),
 				IsRelative: true,
 			},
 			Stop: flux.Time{
@@ -140,11 +140,11 @@ func TestRange_Process(t *testing.T) {
 				Bounds: flux.Bounds{
 					Start: flux.Time{
 						IsRelative: true,
-						Relative:   -5 * time.Minute,
+						Relative:   flux.ConvertDuration(-5 * time.Minute),
 					},
 					Stop: flux.Time{
 						IsRelative: true,
-						Relative:   -2 * time.Minute,
+						Relative:   flux.ConvertDuration(-2 * time.Minute),
 					},
 				},
 				TimeColumn:  "_time",
@@ -188,11 +188,11 @@ func TestRange_Process(t *testing.T) {
 				Bounds: flux.Bounds{
 					Start: flux.Time{
 						IsRelative: true,
-						Relative:   -5 * time.Minute,
+						Relative:   flux.ConvertDuration(-5 * time.Minute),
 					},
 					Stop: flux.Time{
 						IsRelative: true,
-						Relative:   -2 * time.Minute,
+						Relative:   flux.ConvertDuration(-2 * time.Minute),
 					},
 				},
 				TimeColumn:  "_value",
@@ -230,7 +230,7 @@ func TestRange_Process(t *testing.T) {
 				Bounds: flux.Bounds{
 					Start: flux.Time{
 						IsRelative: true,
-						Relative:   -2 * time.Minute,
+						Relative:   flux.ConvertDuration(-2 * time.Minute),
 					},
 					Stop: flux.Time{
 						IsRelative: true,
@@ -274,7 +274,7 @@ func TestRange_Process(t *testing.T) {
 				Bounds: flux.Bounds{
 					Start: flux.Time{
 						IsRelative: true,
-						Relative:   -2 * time.Minute,
+						Relative:   flux.ConvertDuration(-2 * time.Minute),
 					},
 					Stop: flux.Time{
 						IsRelative: true,
@@ -540,11 +540,11 @@ func TestRange_Process(t *testing.T) {
 				Bounds: flux.Bounds{
 					Start: flux.Time{
 						IsRelative: true,
-						Relative:   -2 * time.Minute,
+						Relative:   flux.ConvertDuration(-2 * time.Minute),
 					},
 					Stop: flux.Time{
 						IsRelative: true,
-						Relative:   -5 * time.Minute,
+						Relative:   flux.ConvertDuration(-5 * time.Minute),
 					},
 				},
 				TimeColumn:  "_time",
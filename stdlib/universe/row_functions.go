@@ -0,0 +1,693 @@
+package universe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// RowFunctionsKind is the kind of the physical procedure that replaces
+// a chain of narrow transformations fused by the FuseRowFunctionsRule.
+const RowFunctionsKind = "rowFunctions"
+
+func init() {
+	execute.RegisterTransformation(RowFunctionsKind, createRowFunctionsTransformation)
+	plan.RegisterFusionRules(
+		FuseRowFunctionsRule{},
+	)
+}
+
+// RowFunctionsProcedureSpec applies the filter, map, set and schema mutation
+// procedures in Specs, in order, to each row, without building the tables in between.
+type RowFunctionsProcedureSpec struct {
+	Specs []plan.PhysicalProcedureSpec
+}
+
+func (s *RowFunctionsProcedureSpec) Kind() plan.ProcedureKind {
+	return RowFunctionsKind
+}
+
+func (s *RowFunctionsProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(RowFunctionsProcedureSpec)
+	ns.Specs = make([]plan.PhysicalProcedureSpec, len(s.Specs))
+	for i, spec := range s.Specs {
+		ns.Specs[i] = spec.Copy().(plan.PhysicalProcedureSpec)
+	}
+	return ns
+}
+
+// Cost is the total cost of the fused procedures.
+func (s *RowFunctionsProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	var cost plan.Cost
+	for _, spec := range s.Specs {
+		c, out := spec.Cost(inStats)
+		cost = plan.Add(cost, c)
+		inStats = []plan.Statistics{out}
+	}
+	return cost, plan.SumStatistics(inStats)
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec.
+// The fused procedures only keep the narrow trigger if all of them have it.
+func (s *RowFunctionsProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	for _, spec := range s.Specs {
+		ts, ok := spec.(plan.TriggerAwareProcedureSpec)
+		if !ok {
+			return plan.DefaultTriggerSpec
+		}
+		if _, ok := ts.TriggerSpec().(plan.NarrowTransformationTriggerSpec); !ok {
+			return plan.DefaultTriggerSpec
+		}
+	}
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+// FuseRowFunctionsRule fuses a filter, map, set, rename, drop, keep or duplicate
+// with the one of them that produces its only input.
+//
+//     map                    rowFunctions
+//      |                          |
+//    filter     ==>  (filter -> map -> keep)
+//      |
+//     keep
+//
+// It is a fusion rule so that the other physical rules,
+// like pushing a filter down into its source, apply first.
+type FuseRowFunctionsRule struct{}
+
+func (FuseRowFunctionsRule) Name() string {
+	return "FuseRowFunctionsRule"
+}
+
+func (FuseRowFunctionsRule) Pattern() plan.Pattern {
+	return plan.Any()
+}
+
+func (FuseRowFunctionsRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	top, ok := rowFunctionSpecs(node)
+	if !ok || len(node.Predecessors()) != 1 {
+		return node, false, nil
+	}
+	pred := node.Predecessors()[0]
+	bottom, ok := rowFunctionSpecs(pred)
+	if !ok || len(pred.Successors()) != 1 {
+		return node, false, nil
+	}
+
+	spec := &RowFunctionsProcedureSpec{
+		Specs: append(bottom, top...),
+	}
+	merged, err := plan.MergeToPhysicalNode(node, pred, spec)
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+// rowFunctionSpecs returns copies of the procedures of a physical node that can be fused.
+func rowFunctionSpecs(node plan.Node) ([]plan.PhysicalProcedureSpec, bool) {
+	if _, ok := node.(*plan.PhysicalPlanNode); !ok {
+		return nil, false
+	}
+	switch spec := node.ProcedureSpec().(type) {
+	case *FilterProcedureSpec, *MapProcedureSpec, *SetProcedureSpec, *SchemaMutationProcedureSpec:
+		return []plan.PhysicalProcedureSpec{spec.Copy().(plan.PhysicalProcedureSpec)}, true
+	case *RowFunctionsProcedureSpec:
+		return spec.Copy().(*RowFunctionsProcedureSpec).Specs, true
+	default:
+		return nil, false
+	}
+}
+
+func createRowFunctionsTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*RowFunctionsProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t, err := NewRowFunctionsTransformation(d, cache, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, d, nil
+}
+
+// rowStage is one of the fused procedures.
+// The columns of the rows of a table are the same for every row,
+// so a stage is prepared once for each table and then evaluated for each row.
+type rowStage interface {
+	// prepare prepares the stage for the rows of a table with the given key and columns,
+	// and returns the key and columns of the rows it produces.
+	prepare(key flux.GroupKey, cols []flux.ColMeta) (flux.GroupKey, []flux.ColMeta, error)
+	// reads returns the indexes of the columns whose values eval reads.
+	reads() []int
+	// sources returns, for each column of the rows the stage produces,
+	// the column of the input table that it copies unchanged, or -1,
+	// given the same for the columns of the rows the stage is evaluated for.
+	sources(src []int) []int
+	// eval returns the values of the row produced from the given row,
+	// or false if the row is filtered out.
+	// The returned values are only valid until the next call.
+	eval(vs []values.Value) ([]values.Value, bool, error)
+}
+
+type rowFunctionsTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	stages []rowStage
+	// regroups is set when a map can move rows of a table into other tables.
+	regroups bool
+	// lastMap is the index of the last map stage.
+	lastMap int
+}
+
+func NewRowFunctionsTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *RowFunctionsProcedureSpec) (*rowFunctionsTransformation, error) {
+	t := &rowFunctionsTransformation{
+		d:      d,
+		cache:  cache,
+		stages: make([]rowStage, len(spec.Specs)),
+	}
+	for i, spec := range spec.Specs {
+		switch spec := spec.(type) {
+		case *FilterProcedureSpec:
+			fn, err := execute.NewRowPredicateFn(spec.Fn)
+			if err != nil {
+				return nil, err
+			}
+			t.stages[i] = &filterStage{fn: fn}
+		case *MapProcedureSpec:
+			fn, err := execute.NewRowMapFn(spec.Fn)
+			if err != nil {
+				return nil, err
+			}
+			t.stages[i] = &mapStage{fn: fn, mergeKey: spec.MergeKey}
+			t.regroups = true
+			t.lastMap = i
+		case *SetProcedureSpec:
+			t.stages[i] = &setStage{key: spec.Key, value: values.NewString(spec.Value)}
+		case *SchemaMutationProcedureSpec:
+			mutators := make([]SchemaMutator, len(spec.Mutations))
+			for j, mutation := range spec.Mutations {
+				m, err := mutation.Mutator()
+				if err != nil {
+					return nil, err
+				}
+				mutators[j] = m
+			}
+			t.stages[i] = &schemaMutationStage{mutators: mutators}
+		default:
+			return nil, fmt.Errorf("cannot fuse procedure of kind %v", spec.Kind())
+		}
+	}
+	return t, nil
+}
+
+func (t *rowFunctionsTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *rowFunctionsTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	key, cols := tbl.Key(), tbl.Cols()
+
+	// Only the columns the stages read are converted to values.
+	// The columns that are copied unchanged from the table are appended from it.
+	read := make([]bool, len(cols))
+	src := make([]int, len(cols))
+	for j := range src {
+		src[j] = j
+	}
+	for _, s := range t.stages {
+		var err error
+		if key, cols, err = s.prepare(key, cols); err != nil {
+			return err
+		}
+		for _, j := range s.reads() {
+			if src[j] >= 0 {
+				read[src[j]] = true
+			}
+		}
+		src = s.sources(src)
+	}
+
+	// Without a map every row keeps the key of the table,
+	// so the table is built even if all of its rows are filtered out.
+	var builder execute.TableBuilder
+	if !t.regroups {
+		var err error
+		if builder, err = t.tableBuilder(key, cols); err != nil {
+			return err
+		}
+	}
+
+	keyIdx := make([]int, len(key.Cols()))
+	for k, c := range key.Cols() {
+		if keyIdx[k] = execute.ColIdx(c.Label, cols); keyIdx[k] < 0 {
+			return fmt.Errorf("group key column %q is missing from the table", c.Label)
+		}
+		if t.regroups && src[keyIdx[k]] >= 0 {
+			read[src[keyIdx[k]]] = true
+		}
+	}
+
+	var rowKey flux.GroupKey
+	row := make([]values.Value, len(tbl.Cols()))
+	return tbl.Do(func(cr flux.ColReader) error {
+		l := cr.Len()
+		for i := 0; i < l; i++ {
+			for j := range row {
+				if read[j] {
+					row[j] = execute.ValueForRow(cr, i, j)
+				}
+			}
+			vs, keep, grouped, err := t.eval(row)
+			if err != nil {
+				return err
+			} else if !grouped {
+				continue
+			}
+
+			if t.regroups {
+				if rowKey == nil || !sameKeyValues(rowKey, keyIdx, vs) {
+					keyValues := make([]values.Value, len(keyIdx))
+					for k, j := range keyIdx {
+						keyValues[k] = vs[j]
+					}
+					rowKey = execute.NewGroupKey(key.Cols(), keyValues)
+					if builder, err = t.tableBuilder(rowKey, cols); err != nil {
+						return err
+					}
+				}
+			}
+			if !keep {
+				continue
+			}
+			if err := appendRow(builder, cols, src, vs, cr, i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eval returns the values of the row produced by the stages and whether the row is kept.
+// A map creates the table of each row it produces, even if a filter after it
+// then removes the row, so a row removed after the last map is still evaluated
+// by the stages that follow to find the key of its table, and grouped is set for it.
+func (t *rowFunctionsTransformation) eval(vs []values.Value) (out []values.Value, keep, grouped bool, err error) {
+	keep = true
+	for i, s := range t.stages {
+		if _, ok := s.(*filterStage); ok && !keep {
+			continue
+		}
+		var pass bool
+		if vs, pass, err = s.eval(vs); err != nil {
+			return nil, false, false, err
+		} else if !pass {
+			if !t.regroups || i < t.lastMap {
+				return nil, false, false, nil
+			}
+			keep = false
+		}
+	}
+	return vs, keep, true, nil
+}
+
+func (t *rowFunctionsTransformation) tableBuilder(key flux.GroupKey, cols []flux.ColMeta) (execute.TableBuilder, error) {
+	builder, created := t.cache.TableBuilder(key)
+	if created {
+		for _, c := range cols {
+			if _, err := builder.AddCol(c); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return builder, nil
+}
+
+func (t *rowFunctionsTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *rowFunctionsTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *rowFunctionsTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// sameKeyValues reports whether the row has the values of the key at the given columns.
+func sameKeyValues(key flux.GroupKey, keyIdx []int, vs []values.Value) bool {
+	for k, j := range keyIdx {
+		v := key.Value(k)
+		if v.IsNull() || vs[j].IsNull() {
+			if v.IsNull() != vs[j].IsNull() {
+				return false
+			}
+			continue
+		}
+		if !v.Equal(vs[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendRow appends row i of cr, as produced by the stages, to the builder.
+// The columns that copy a column of cr are appended from it and the others from the values.
+// The builder may have been created by another table with the same key,
+// so the columns are matched by label when their order differs.
+func appendRow(builder execute.TableBuilder, cols []flux.ColMeta, src []int, vs []values.Value, cr flux.ColReader, i int) error {
+	for j, c := range builder.Cols() {
+		k := j
+		if k >= len(cols) || cols[k].Label != c.Label {
+			if k = execute.ColIdx(c.Label, cols); k < 0 {
+				return fmt.Errorf("could not find value for column %q", c.Label)
+			}
+		}
+		var err error
+		if src[k] >= 0 {
+			err = appendColValue(builder, j, cr, i, src[k])
+		} else {
+			err = builder.AppendValue(j, vs[k])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendColValue appends the value of row i of column k of cr to column j of the builder.
+func appendColValue(builder execute.TableBuilder, j int, cr flux.ColReader, i, k int) error {
+	switch typ := cr.Cols()[k].Type; typ {
+	case flux.TBool:
+		vs := cr.Bools(k)
+		if vs.IsNull(i) {
+			return builder.AppendNil(j)
+		}
+		return builder.AppendBool(j, vs.Value(i))
+	case flux.TInt:
+		vs := cr.Ints(k)
+		if vs.IsNull(i) {
+			return builder.AppendNil(j)
+		}
+		return builder.AppendInt(j, vs.Value(i))
+	case flux.TUInt:
+		vs := cr.UInts(k)
+		if vs.IsNull(i) {
+			return builder.AppendNil(j)
+		}
+		return builder.AppendUInt(j, vs.Value(i))
+	case flux.TFloat:
+		vs := cr.Floats(k)
+		if vs.IsNull(i) {
+			return builder.AppendNil(j)
+		}
+		return builder.AppendFloat(j, vs.Value(i))
+	case flux.TString:
+		vs := cr.Strings(k)
+		if vs.IsNull(i) {
+			return builder.AppendNil(j)
+		}
+		return builder.AppendString(j, vs.ValueString(i))
+	case flux.TTime:
+		vs := cr.Times(k)
+		if vs.IsNull(i) {
+			return builder.AppendNil(j)
+		}
+		return builder.AppendTime(j, execute.Time(vs.Value(i)))
+	default:
+		execute.PanicUnknownType(typ)
+		return nil
+	}
+}
+
+type filterStage struct {
+	fn *execute.RowPredicateFn
+
+	refIdx []int
+}
+
+func (s *filterStage) prepare(key flux.GroupKey, cols []flux.ColMeta) (flux.GroupKey, []flux.ColMeta, error) {
+	if err := s.fn.Prepare(cols); err != nil {
+		return nil, nil, err
+	}
+	s.refIdx = referenceIndexes(s.refIdx, s.fn.References(), cols)
+	return key, cols, nil
+}
+
+func (s *filterStage) reads() []int {
+	return s.refIdx
+}
+
+func (s *filterStage) sources(src []int) []int {
+	return src
+}
+
+func (s *filterStage) eval(vs []values.Value) ([]values.Value, bool, error) {
+	pass, err := s.fn.EvalValues(vs)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to evaluate filter function")
+	}
+	return vs, pass, nil
+}
+
+type mapStage struct {
+	fn       *execute.RowMapFn
+	mergeKey bool
+
+	cols []flux.ColMeta
+	// keyIdx is the index of the input column whose value is used
+	// when the function does not return a column of the key, or -1.
+	keyIdx []int
+	// copyIdx is the index of the input column that a column copies
+	// because the function does not return it, or -1.
+	copyIdx []int
+	refIdx  []int
+	src     []int
+	out     []values.Value
+}
+
+func (s *mapStage) prepare(key flux.GroupKey, cols []flux.ColMeta) (flux.GroupKey, []flux.ColMeta, error) {
+	if err := s.fn.Prepare(cols); err != nil {
+		return nil, nil, err
+	}
+	properties := s.fn.Type().Properties()
+	labels := make([]string, 0, len(properties))
+	for k := range properties {
+		labels = append(labels, k)
+	}
+	sort.Strings(labels)
+
+	// The columns are the ones the map transformation adds to its tables.
+	s.cols = make([]flux.ColMeta, 0, len(key.Cols())+len(labels))
+	s.keyIdx = s.keyIdx[:0]
+	if s.mergeKey {
+		for _, c := range key.Cols() {
+			s.cols = append(s.cols, c)
+			s.keyIdx = append(s.keyIdx, execute.ColIdx(c.Label, cols))
+		}
+	}
+	for _, label := range labels {
+		if s.mergeKey && key.HasCol(label) {
+			continue
+		}
+		s.cols = append(s.cols, flux.ColMeta{
+			Label: label,
+			Type:  execute.ConvertFromKind(properties[label].Nature()),
+		})
+		s.keyIdx = append(s.keyIdx, -1)
+	}
+	s.out = make([]values.Value, len(s.cols))
+	s.copyIdx = s.copyIdx[:0]
+	for j, c := range s.cols {
+		if _, ok := properties[c.Label]; ok {
+			s.copyIdx = append(s.copyIdx, -1)
+		} else {
+			s.copyIdx = append(s.copyIdx, s.keyIdx[j])
+		}
+	}
+	s.refIdx = referenceIndexes(s.refIdx, s.fn.References(), cols)
+
+	// Only the values of the key change from row to row,
+	// so the key of the table keeps the values of the input key.
+	keyCols := make([]flux.ColMeta, 0, len(key.Cols()))
+	keyValues := make([]values.Value, 0, len(key.Cols()))
+	for _, c := range cols {
+		idx := execute.ColIdx(c.Label, key.Cols())
+		if idx < 0 || !(s.mergeKey || execute.ContainsStr(labels, c.Label)) {
+			continue
+		}
+		keyCols = append(keyCols, c)
+		keyValues = append(keyValues, key.Value(idx))
+	}
+	return execute.NewGroupKey(keyCols, keyValues), s.cols, nil
+}
+
+func (s *mapStage) reads() []int {
+	return s.refIdx
+}
+
+func (s *mapStage) sources(src []int) []int {
+	s.src = s.src[:0]
+	for _, k := range s.copyIdx {
+		if k < 0 {
+			s.src = append(s.src, -1)
+		} else {
+			s.src = append(s.src, src[k])
+		}
+	}
+	return s.src
+}
+
+func (s *mapStage) eval(vs []values.Value) ([]values.Value, bool, error) {
+	m, err := s.fn.EvalValues(vs)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to evaluate map function")
+	}
+	for j, c := range s.cols {
+		v, ok := m.Get(c.Label)
+		if !ok {
+			if s.keyIdx[j] < 0 {
+				return nil, false, fmt.Errorf("could not find value for column %q", c.Label)
+			}
+			v = vs[s.keyIdx[j]]
+		}
+		s.out[j] = v
+	}
+	return s.out, true, nil
+}
+
+type setStage struct {
+	key   string
+	value values.Value
+
+	idx int
+	src []int
+	out []values.Value
+}
+
+func (s *setStage) prepare(key flux.GroupKey, cols []flux.ColMeta) (flux.GroupKey, []flux.ColMeta, error) {
+	if idx := execute.ColIdx(s.key, key.Cols()); idx >= 0 {
+		keyValues := make([]values.Value, len(key.Cols()))
+		for j := range key.Cols() {
+			if j == idx {
+				keyValues[j] = s.value
+			} else {
+				keyValues[j] = key.Value(j)
+			}
+		}
+		key = execute.NewGroupKey(key.Cols(), keyValues)
+	}
+	if s.idx = execute.ColIdx(s.key, cols); s.idx < 0 {
+		s.idx = len(cols)
+		cols = append(cols[:len(cols):len(cols)], flux.ColMeta{
+			Label: s.key,
+			Type:  flux.TString,
+		})
+	}
+	s.out = make([]values.Value, len(cols))
+	return key, cols, nil
+}
+
+func (s *setStage) reads() []int {
+	return nil
+}
+
+func (s *setStage) sources(src []int) []int {
+	s.src = append(s.src[:0], src...)
+	if s.idx == len(s.src) {
+		s.src = append(s.src, -1)
+	}
+	s.src[s.idx] = -1
+	return s.src
+}
+
+func (s *setStage) eval(vs []values.Value) ([]values.Value, bool, error) {
+	copy(s.out, vs)
+	s.out[s.idx] = s.value
+	return s.out, true, nil
+}
+
+type schemaMutationStage struct {
+	mutators []SchemaMutator
+
+	colMap []int
+	nulls  []values.Value
+	src    []int
+	out    []values.Value
+}
+
+func (s *schemaMutationStage) prepare(key flux.GroupKey, cols []flux.ColMeta) (flux.GroupKey, []flux.ColMeta, error) {
+	colMap := make([]int, len(cols))
+	for i := range cols {
+		colMap[i] = i
+	}
+	// The mutators change the columns in place,
+	// and the previous stages keep using them.
+	ctx := &BuilderContext{
+		TableColumns: append([]flux.ColMeta(nil), cols...),
+		TableKey:     key,
+		ColIdxMap:    colMap,
+	}
+	for _, m := range s.mutators {
+		if err := m.Mutate(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	s.colMap = ctx.ColMap()
+	s.nulls = make([]values.Value, len(ctx.Cols()))
+	for j, c := range ctx.Cols() {
+		if s.colMap[j] < 0 {
+			s.nulls[j] = values.NewNull(execute.ConvertToKind(c.Type))
+		}
+	}
+	s.out = make([]values.Value, len(ctx.Cols()))
+	return ctx.Key(), ctx.Cols(), nil
+}
+
+func (s *schemaMutationStage) reads() []int {
+	return nil
+}
+
+func (s *schemaMutationStage) sources(src []int) []int {
+	s.src = s.src[:0]
+	for _, k := range s.colMap {
+		if k < 0 {
+			s.src = append(s.src, -1)
+		} else {
+			s.src = append(s.src, src[k])
+		}
+	}
+	return s.src
+}
+
+func (s *schemaMutationStage) eval(vs []values.Value) ([]values.Value, bool, error) {
+	for j, k := range s.colMap {
+		if k < 0 {
+			s.out[j] = s.nulls[j]
+		} else {
+			s.out[j] = vs[k]
+		}
+	}
+	return s.out, true, nil
+}
+
+// referenceIndexes returns the indexes of the columns with the given labels,
+// reusing the memory of idx.
+func referenceIndexes(idx []int, labels []string, cols []flux.ColMeta) []int {
+	idx = idx[:0]
+	for _, label := range labels {
+		if j := execute.ColIdx(label, cols); j >= 0 {
+			idx = append(idx, j)
+		}
+	}
+	return idx
+}
@@ -0,0 +1,623 @@
+package universe_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+// (r) => r._value > 1.0
+var valueAboveOneFn = &semantic.FunctionExpression{
+	Block: &semantic.FunctionBlock{
+		Parameters: &semantic.FunctionParameters{
+			List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+		},
+		Body: &semantic.BinaryExpression{
+			Operator: ast.GreaterThanOperator,
+			Left: &semantic.MemberExpression{
+				Object:   &semantic.IdentifierExpression{Name: "r"},
+				Property: "_value",
+			},
+			Right: &semantic.FloatLiteral{Value: 1},
+		},
+	},
+}
+
+// (r) => ({_time: r._time, _value: r._value * 2.0})
+var doubleValueFn = &semantic.FunctionExpression{
+	Block: &semantic.FunctionBlock{
+		Parameters: &semantic.FunctionParameters{
+			List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+		},
+		Body: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{
+					Key: &semantic.Identifier{Name: "_time"},
+					Value: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_time",
+					},
+				},
+				{
+					Key: &semantic.Identifier{Name: "_value"},
+					Value: &semantic.BinaryExpression{
+						Operator: ast.MultiplicationOperator,
+						Left: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_value",
+						},
+						Right: &semantic.FloatLiteral{Value: 2},
+					},
+				},
+			},
+		},
+	},
+}
+
+// (r) => ({_time: r._time, _value: r._value, t0: r.host})
+var hostToKeyFn = &semantic.FunctionExpression{
+	Block: &semantic.FunctionBlock{
+		Parameters: &semantic.FunctionParameters{
+			List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+		},
+		Body: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{
+					Key: &semantic.Identifier{Name: "_time"},
+					Value: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_time",
+					},
+				},
+				{
+					Key: &semantic.Identifier{Name: "_value"},
+					Value: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_value",
+					},
+				},
+				{
+					Key: &semantic.Identifier{Name: "t0"},
+					Value: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "host",
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestFuseRowFunctionsRule(t *testing.T) {
+	var (
+		from   = &influxdb.FromProcedureSpec{Bucket: "telegraf"}
+		filter = &universe.FilterProcedureSpec{Fn: valueAboveOneFn}
+		mapFn  = &universe.MapProcedureSpec{Fn: doubleValueFn, MergeKey: true}
+		set    = &universe.SetProcedureSpec{Key: "t1", Value: "a"}
+		keep   = &universe.SchemaMutationProcedureSpec{
+			Mutations: []universe.SchemaMutation{&universe.KeepOpSpec{Columns: []string{"_time", "_value"}}},
+		}
+		sort = &universe.SortProcedureSpec{Columns: []string{"_value"}}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "filter map",
+			Rules: []plan.Rule{universe.FuseRowFunctionsRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("filter", filter),
+					plan.CreatePhysicalNode("map", mapFn),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("merged_filter_map", &universe.RowFunctionsProcedureSpec{
+						Specs: []plan.PhysicalProcedureSpec{filter, mapFn},
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "chain",
+			Rules: []plan.Rule{universe.FuseRowFunctionsRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("filter", filter),
+					plan.CreatePhysicalNode("map", mapFn),
+					plan.CreatePhysicalNode("set", set),
+					plan.CreatePhysicalNode("keep", keep),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("merged_filter_map_set_keep", &universe.RowFunctionsProcedureSpec{
+						Specs: []plan.PhysicalProcedureSpec{filter, mapFn, set, keep},
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "split by sort",
+			Rules: []plan.Rule{universe.FuseRowFunctionsRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("filter", filter),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("map", mapFn),
+					plan.CreatePhysicalNode("set", set),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("filter", filter),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("merged_map_set", &universe.RowFunctionsProcedureSpec{
+						Specs: []plan.PhysicalProcedureSpec{mapFn, set},
+					}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {2, 3}},
+			},
+		},
+		{
+			Name:  "rows used twice",
+			Rules: []plan.Rule{universe.FuseRowFunctionsRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("filter", filter),
+					plan.CreatePhysicalNode("map", mapFn),
+					plan.CreatePhysicalNode("set", set),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {1, 3}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "single row function",
+			Rules: []plan.Rule{universe.FuseRowFunctionsRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("map", mapFn),
+					plan.CreatePhysicalNode("sort", sort),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
+
+func TestRowFunctions_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *universe.RowFunctionsProcedureSpec
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "filter map rename",
+			spec: &universe.RowFunctionsProcedureSpec{
+				Specs: []plan.PhysicalProcedureSpec{
+					&universe.FilterProcedureSpec{Fn: valueAboveOneFn},
+					&universe.MapProcedureSpec{Fn: doubleValueFn, MergeKey: true},
+					&universe.SchemaMutationProcedureSpec{
+						Mutations: []universe.SchemaMutation{&universe.RenameOpSpec{Columns: map[string]string{"t0": "tag"}}},
+					},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "a", "x"},
+					{execute.Time(2), 1.0, "a", "y"},
+					{execute.Time(3), 3.0, "a", "x"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"tag"},
+				ColMeta: []flux.ColMeta{
+					{Label: "tag", Type: flux.TString},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", execute.Time(1), 4.0},
+					{"a", execute.Time(3), 6.0},
+				},
+			}},
+		},
+		{
+			name: "map regroups",
+			spec: &universe.RowFunctionsProcedureSpec{
+				Specs: []plan.PhysicalProcedureSpec{
+					&universe.MapProcedureSpec{Fn: hostToKeyFn, MergeKey: true},
+					&universe.FilterProcedureSpec{Fn: valueAboveOneFn},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "a", "x"},
+					{execute.Time(2), 4.0, "a", "y"},
+					{execute.Time(3), 3.0, "a", "x"},
+					{execute.Time(4), 1.0, "a", "z"},
+				},
+			}},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"x", execute.Time(1), 2.0},
+						{"x", execute.Time(3), 3.0},
+					},
+				},
+				{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"y", execute.Time(2), 4.0},
+					},
+				},
+				{
+					KeyCols:   []string{"t0"},
+					KeyValues: []interface{}{"z"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+				},
+			},
+		},
+		{
+			name: "set key and drop",
+			spec: &universe.RowFunctionsProcedureSpec{
+				Specs: []plan.PhysicalProcedureSpec{
+					&universe.SetProcedureSpec{Key: "t0", Value: "b"},
+					&universe.SetProcedureSpec{Key: "t1", Value: "c"},
+					&universe.SchemaMutationProcedureSpec{
+						Mutations: []universe.SchemaMutation{&universe.DropOpSpec{Columns: []string{"host"}}},
+					},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "a", "x"},
+					{execute.Time(2), nil, "a", "y"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+					{Label: "t1", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "b", "c"},
+					{execute.Time(2), nil, "b", "c"},
+				},
+			}},
+		},
+		{
+			name: "filter removes every row",
+			spec: &universe.RowFunctionsProcedureSpec{
+				Specs: []plan.PhysicalProcedureSpec{
+					&universe.FilterProcedureSpec{Fn: valueAboveOneFn},
+					&universe.SchemaMutationProcedureSpec{
+						Mutations: []universe.SchemaMutation{&universe.KeepOpSpec{Columns: []string{"_value", "t0"}}},
+					},
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 0.5, "a"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols:   []string{"t0"},
+				KeyValues: []interface{}{"a"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				nil,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					tx, err := universe.NewRowFunctionsTransformation(d, c, tc.spec)
+					if err != nil {
+						t.Fatal(err)
+					}
+					return tx
+				},
+			)
+		})
+	}
+}
+
+// runRowFunctionsQuery runs the script and returns its sorted tables
+// along with the number of fused row functions nodes in its plan.
+func runRowFunctionsQuery(t *testing.T, script string, opts ...lang.CompileOption) ([]*executetest.Table, int) {
+	t.Helper()
+	program, err := lang.Compile(script, time.Unix(0, 0), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	var tables []*executetest.Table
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			cb, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, cb)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	sort.Sort(executetest.SortedTables(tables))
+
+	fused := 0
+	if err := program.PlanSpec.TopDownWalk(func(node plan.Node) error {
+		if node.Kind() == universe.RowFunctionsKind {
+			fused++
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return tables, fused
+}
+
+func TestRowFunctions_Fusion(t *testing.T) {
+	const data = `#datatype,string,long,dateTime:RFC3339,string,string,double
+#group,false,false,false,true,false,false
+#default,_result,,,,,
+,result,table,_time,host,region,_value
+,,0,2019-01-01T00:00:00Z,a,east,1
+,,0,2019-01-01T00:00:10Z,a,west,2
+,,1,2019-01-01T00:00:00Z,b,east,3
+,,1,2019-01-01T00:00:10Z,b,west,4
+,,1,2019-01-01T00:00:20Z,b,east,5
+`
+	testCases := []struct {
+		name   string
+		script string
+	}{
+		{
+			name:   "map filter count",
+			script: `map(fn: (r) => ({_time: r._time, _value: r._value * 2.0})) |> filter(fn: (r) => r._value > 5.0) |> count()`,
+		},
+		{
+			name:   "map regroups filter rename",
+			script: `map(fn: (r) => ({_time: r._time, _value: r._value, host: r.region})) |> filter(fn: (r) => r._value > 3.0) |> rename(columns: {host: "region"})`,
+		},
+		{
+			name:   "filter map filter",
+			script: `filter(fn: (r) => r._value > 1.0) |> map(fn: (r) => ({_time: r._time, _value: r._value, host: r.region})) |> filter(fn: (r) => r._value > 4.0)`,
+		},
+		{
+			name:   "filter set drop",
+			script: `filter(fn: (r) => r._value > 1.0) |> set(key: "host", value: "c") |> drop(columns: ["region"])`,
+		},
+		{
+			name:   "filter removes every row keep",
+			script: `filter(fn: (r) => r._value > 10.0) |> keep(columns: ["_value", "host"])`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			script := fmt.Sprintf("import \"csv\"\ncsv.from(csv: %q) |> %s", data, tc.script)
+			want, fused := runRowFunctionsQuery(t, script, lang.WithPhysPlanOpts(plan.DisableFusion()))
+			if fused != 0 {
+				t.Fatalf("unexpected fused nodes in the plan without fusion: %d", fused)
+			}
+			got, fused := runRowFunctionsQuery(t, script)
+			if fused != 1 {
+				t.Fatalf("unexpected fused nodes in the plan -want/+got:\n\t- 1\n\t+ %d", fused)
+			}
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected tables of the fused plan -want/+got:\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+// BenchmarkRowFunctions compares the fused transformation
+// with the chain of transformations of the unfused plan.
+func BenchmarkRowFunctions(b *testing.B) {
+	builder := execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), executetest.UnlimitedAllocator)
+	for _, c := range []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "t0", Type: flux.TString},
+		{Label: "host", Type: flux.TString},
+		{Label: "region", Type: flux.TString},
+	} {
+		if _, err := builder.AddCol(c); err != nil {
+			b.Fatal(err)
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		_ = builder.AppendTime(0, execute.Time(i))
+		_ = builder.AppendFloat(1, float64(i%4))
+		_ = builder.AppendString(2, fmt.Sprintf("t%d", i%2))
+		_ = builder.AppendString(3, fmt.Sprintf("host%d", i%10))
+		_ = builder.AppendString(4, "east")
+	}
+	tbl, err := builder.Table()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	benchmarks := []struct {
+		name  string
+		specs []plan.PhysicalProcedureSpec
+	}{
+		{
+			name: "filter set drop",
+			specs: []plan.PhysicalProcedureSpec{
+				&universe.FilterProcedureSpec{Fn: valueAboveOneFn},
+				&universe.SetProcedureSpec{Key: "t1", Value: "c"},
+				&universe.SchemaMutationProcedureSpec{
+					Mutations: []universe.SchemaMutation{&universe.DropOpSpec{Columns: []string{"region"}}},
+				},
+			},
+		},
+		{
+			name: "filter map",
+			specs: []plan.PhysicalProcedureSpec{
+				&universe.FilterProcedureSpec{Fn: valueAboveOneFn},
+				&universe.MapProcedureSpec{Fn: doubleValueFn, MergeKey: true},
+			},
+		},
+	}
+	for _, bm := range benchmarks {
+		fused := []plan.PhysicalProcedureSpec{&universe.RowFunctionsProcedureSpec{Specs: bm.specs}}
+		b.Run(bm.name+"/fused", func(b *testing.B) {
+			benchmarkTransformations(b, tbl, fused)
+		})
+		b.Run(bm.name+"/unfused", func(b *testing.B) {
+			benchmarkTransformations(b, tbl, bm.specs)
+		})
+	}
+}
+
+// benchmarkTransformations processes the table with a transformation for each spec in turn,
+// building the tables of each transformation before they are processed by the next one.
+func benchmarkTransformations(b *testing.B, tbl flux.Table, specs []plan.PhysicalProcedureSpec) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		tables := []flux.Table{tbl}
+		for _, spec := range specs {
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+			var tx execute.Transformation
+			var err error
+			switch spec := spec.(type) {
+			case *universe.RowFunctionsProcedureSpec:
+				tx, err = universe.NewRowFunctionsTransformation(d, c, spec)
+			case *universe.FilterProcedureSpec:
+				tx, err = universe.NewFilterTransformation(d, c, spec)
+			case *universe.MapProcedureSpec:
+				tx, err = universe.NewMapTransformation(d, c, spec)
+			case *universe.SetProcedureSpec:
+				tx = universe.NewSetTransformation(d, c, spec)
+			case *universe.SchemaMutationProcedureSpec:
+				tx, err = universe.NewSchemaMutationTransformation(d, c, spec)
+			}
+			if err != nil {
+				b.Fatal(err)
+			}
+			parentID := executetest.RandomDatasetID()
+			for _, t := range tables {
+				if err := tx.Process(parentID, t); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			tables = nil
+			c.ForEachBuilder(func(_ flux.GroupKey, builder execute.TableBuilder) {
+				t, err := builder.Table()
+				if err != nil {
+					b.Fatal(err)
+				}
+				tables = append(tables, t)
+			})
+		}
+	}
+}
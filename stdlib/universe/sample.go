@@ -82,6 +82,16 @@ type SampleProcedureSpec struct {
 	execute.SelectorConfig
 }
 
+// Cost estimates that the sample selects every Nth row of each table.
+func (s *SampleProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	out := in
+	if s.N > 0 {
+		out.Cardinality = in.Cardinality / s.N
+	}
+	return plan.Cost{CPU: in.Cardinality}, out
+}
+
 func newSampleProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	spec, ok := qs.(*SampleOpSpec)
 	if !ok {
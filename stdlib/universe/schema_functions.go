@@ -134,6 +134,7 @@ func init() {
 
 	plan.RegisterProcedureSpec(SchemaMutationKind, newSchemaMutationProcedure, SchemaMutationOps...)
 	execute.RegisterTransformation(SchemaMutationKind, createSchemaMutationTransformation)
+	plan.RegisterPhysicalRules(PushDownProjectionRule{})
 }
 
 func createRenameOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -341,9 +342,12 @@ func (s *DuplicateOpSpec) Kind() flux.OperationKind {
 }
 
 func (s *RenameOpSpec) Copy() SchemaMutation {
-	newCols := make(map[string]string, len(s.Columns))
-	for k, v := range s.Columns {
-		newCols[k] = v
+	var newCols map[string]string
+	if s.Columns != nil {
+		newCols = make(map[string]string, len(s.Columns))
+		for k, v := range s.Columns {
+			newCols[k] = v
+		}
 	}
 
 	return &RenameOpSpec{
@@ -353,8 +357,11 @@ func (s *RenameOpSpec) Copy() SchemaMutation {
 }
 
 func (s *DropOpSpec) Copy() SchemaMutation {
-	newCols := make([]string, len(s.Columns))
-	copy(newCols, s.Columns)
+	var newCols []string
+	if s.Columns != nil {
+		newCols = make([]string, len(s.Columns))
+		copy(newCols, s.Columns)
+	}
 
 	return &DropOpSpec{
 		Columns:   newCols,
@@ -363,8 +370,11 @@ func (s *DropOpSpec) Copy() SchemaMutation {
 }
 
 func (s *KeepOpSpec) Copy() SchemaMutation {
-	newCols := make([]string, len(s.Columns))
-	copy(newCols, s.Columns)
+	var newCols []string
+	if s.Columns != nil {
+		newCols = make([]string, len(s.Columns))
+		copy(newCols, s.Columns)
+	}
 
 	return &KeepOpSpec{
 		Columns:   newCols,
@@ -422,7 +432,7 @@ func (s *SchemaMutationProcedureSpec) Kind() plan.ProcedureKind {
 
 func (s *SchemaMutationProcedureSpec) Copy() plan.ProcedureSpec {
 	newMutations := make([]SchemaMutation, len(s.Mutations))
-	for i, m := range newMutations {
+	for i, m := range s.Mutations {
 		newMutations[i] = m.Copy()
 	}
 
@@ -442,6 +452,74 @@ func newSchemaMutationProcedure(qs flux.OperationSpec, pa plan.Administration) (
 	}, nil
 }
 
+// PushDownProjectionRule pushes the columns of keep and drop into a source
+// that can leave the other columns out of its tables.
+// Only the columns named by keep and drop are pushed down, not their predicates.
+//
+//     keep
+//       |     ==>   source (columns)
+//     source
+type PushDownProjectionRule struct{}
+
+func (PushDownProjectionRule) Name() string {
+	return "PushDownProjectionRule"
+}
+
+func (PushDownProjectionRule) Pattern() plan.Pattern {
+	return plan.Pat(SchemaMutationKind, plan.Any())
+}
+
+func (PushDownProjectionRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	sourceNode := node.Predecessors()[0]
+	if len(sourceNode.Predecessors()) != 0 {
+		return node, false, nil
+	}
+	sourceSpec := sourceNode.ProcedureSpec()
+	if _, ok := sourceSpec.(plan.ProjectionSource); !ok {
+		return node, false, nil
+	}
+
+	// The mutations are applied in order, so only the leading
+	// keep and drop mutations can be applied by the source.
+	spec := node.ProcedureSpec().(*SchemaMutationProcedureSpec)
+	pushed := 0
+	for _, m := range spec.Mutations {
+		var p plan.Projection
+		switch m := m.(type) {
+		case *KeepOpSpec:
+			p = plan.Projection{Columns: m.Columns}
+		case *DropOpSpec:
+			p = plan.Projection{Columns: m.Columns, Drop: true}
+		}
+		src, ok := sourceSpec.(plan.ProjectionSource)
+		if p.Columns == nil || !ok {
+			break
+		}
+		newSpec, ok := src.PushDownProjection(p)
+		if !ok {
+			break
+		}
+		sourceSpec = newSpec
+		pushed++
+	}
+	if pushed == 0 {
+		return node, false, nil
+	}
+
+	if err := sourceNode.ReplaceSpec(sourceSpec); err != nil {
+		return nil, false, err
+	}
+	if pushed == len(spec.Mutations) {
+		return sourceNode, true, nil
+	}
+	rest := spec.Copy().(*SchemaMutationProcedureSpec)
+	rest.Mutations = rest.Mutations[pushed:]
+	if err := node.ReplaceSpec(rest); err != nil {
+		return nil, false, err
+	}
+	return node, true, nil
+}
+
 type schemaMutationTransformation struct {
 	d        execute.Dataset
 	cache    execute.TableBuilderCache
@@ -9,8 +9,10 @@ import (
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/generate"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 	"github.com/pkg/errors"
@@ -1269,3 +1271,128 @@ func TestRenameDrop_PushDown(t *testing.T) {
 	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
 }
 */
+
+func TestPushDownProjectionRule(t *testing.T) {
+	var (
+		from   = &influxdb.FromProcedureSpec{Bucket: "telegraf"}
+		keep   = &universe.KeepOpSpec{Columns: []string{"_time", "_value"}}
+		drop   = &universe.DropOpSpec{Columns: []string{"host"}}
+		rename = &universe.RenameOpSpec{Columns: map[string]string{"_value": "value"}}
+		keepFn = &universe.KeepOpSpec{Predicate: &semantic.FunctionExpression{}}
+	)
+	mutations := func(ms ...universe.SchemaMutation) *universe.SchemaMutationProcedureSpec {
+		return &universe.SchemaMutationProcedureSpec{Mutations: ms}
+	}
+	projected := func(p plan.Projection) *influxdb.FromProcedureSpec {
+		return &influxdb.FromProcedureSpec{Bucket: "telegraf", Projection: &p}
+	}
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "keep",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("keep", mutations(keep)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", projected(plan.Projection{Columns: []string{"_time", "_value"}})),
+				},
+			},
+		},
+		{
+			Name:  "drop",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("drop", mutations(drop)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", projected(plan.Projection{Columns: []string{"host"}, Drop: true})),
+				},
+			},
+		},
+		{
+			Name:  "leading mutations",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("keep", mutations(keep, rename)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", projected(plan.Projection{Columns: []string{"_time", "_value"}})),
+					plan.CreatePhysicalNode("keep", mutations(rename)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "rename first",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("rename", mutations(rename, keep)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "keep predicate",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("keep", mutations(keepFn)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "source rejects projection",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", projected(plan.Projection{Columns: []string{"host"}, Drop: true})),
+					plan.CreatePhysicalNode("keep", mutations(keep)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "not a projection source",
+			Rules: []plan.Rule{universe.PushDownProjectionRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("generate", &generate.FromGeneratorProcedureSpec{Count: 10}),
+					plan.CreatePhysicalNode("keep", mutations(keep)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
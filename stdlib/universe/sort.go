@@ -31,6 +31,7 @@ func init() {
 	flux.RegisterOpSpec(SortKind, newSortOp)
 	plan.RegisterProcedureSpec(SortKind, newSortProcedure, SortKind)
 	execute.RegisterTransformation(SortKind, createSortTransformation)
+	plan.RegisterPhysicalRules(SortFilterRule{})
 }
 
 func createSortOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -70,11 +71,16 @@ func (s *SortOpSpec) Kind() flux.OperationKind {
 }
 
 type SortProcedureSpec struct {
-	plan.DefaultCost
 	Columns []string
 	Desc    bool
 }
 
+// Cost estimates the cost of sorting every row of the input.
+func (s *SortProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.SortCost(in), in
+}
+
 func newSortProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	spec, ok := qs.(*SortOpSpec)
 	if !ok {
@@ -111,14 +117,32 @@ func createSortTransformation(id execute.DatasetID, mode execute.AccumulationMod
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
 	cache := execute.NewTableBuilderCache(a.Allocator())
-	d := execute.NewDataset(id, mode, cache)
+	spiller := execute.NewSpiller(execute.GetSpillConfig(a.Dependencies()), a.Allocator())
+	if !spiller.Enabled() {
+		d := execute.NewDataset(id, mode, cache)
+		t := NewSortTransformation(d, cache, s)
+		return t, d, nil
+	}
+	spill := &sortSpill{
+		DataCache: cache,
+		builders:  cache,
+		spiller:   spiller,
+		runs:      execute.NewGroupLookup(),
+		cols:      s.Columns,
+		desc:      s.Desc,
+	}
+	d := execute.NewDataset(id, mode, spill)
 	t := NewSortTransformation(d, cache, s)
+	t.spill = spill
 	return t, d, nil
 }
 
 type sortTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
+	// spill holds the tables that were sorted on disk.
+	// It is nil when spilling to disk is disabled.
+	spill *sortSpill
 
 	cols []string
 	desc bool
@@ -153,6 +177,9 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	if err := execute.AddTableCols(tbl, builder); err != nil {
 		return err
 	}
+	if t.spill != nil {
+		return t.spill.process(key, tbl, builder)
+	}
 	if err := execute.AppendTable(tbl, builder); err != nil {
 		return err
 	}
@@ -169,6 +196,9 @@ func (t *sortTransformation) UpdateProcessingTime(id execute.DatasetID, pt execu
 }
 func (t *sortTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
+	if t.spill != nil {
+		t.spill.spiller.Close()
+	}
 }
 
 func (t *sortTransformation) sortedKey(key flux.GroupKey) flux.GroupKey {
@@ -192,3 +222,144 @@ func (t *sortTransformation) sortedKey(key flux.GroupKey) flux.GroupKey {
 	}
 	return execute.NewGroupKey(cols, vs)
 }
+
+// sortSpill is the data cache of a sort transformation that sorts on disk
+// the tables that do not fit within the memory limit of the query.
+// When memory runs low, every table in memory is written to disk as a sorted run.
+// The runs of a table are merged when the table is read.
+type sortSpill struct {
+	execute.DataCache
+	builders execute.TableBuilderCache
+	spiller  *execute.Spiller
+	// runs holds the sorted runs of each table that was spilled.
+	runs *execute.GroupLookup
+
+	cols []string
+	desc bool
+}
+
+// process sorts the table using the builder, spilling sorted runs to disk when memory runs low.
+func (s *sortSpill) process(key flux.GroupKey, tbl flux.Table, builder execute.TableBuilder) error {
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if s.spiller.ShouldSpill(execute.AppendSize(builder, cr)) {
+			if err := s.spillAll(key); err != nil {
+				return err
+			}
+		}
+		return execute.AppendCols(cr, builder)
+	}); err != nil {
+		return err
+	}
+	builder.Sort(s.cols, s.desc)
+	if _, ok := s.runs.Lookup(key); ok {
+		// The rest of a table that was partially spilled is its last run.
+		return s.spillRun(key, builder)
+	}
+	return nil
+}
+
+// spillAll writes every table in memory to disk.
+// The table with the current key has not been sorted yet.
+func (s *sortSpill) spillAll(current flux.GroupKey) (err error) {
+	s.builders.ForEachBuilder(func(key flux.GroupKey, builder execute.TableBuilder) {
+		if err != nil || builder.NRows() == 0 {
+			return
+		}
+		if key.Equal(current) {
+			builder.Sort(s.cols, s.desc)
+		}
+		err = s.spillRun(key, builder)
+	})
+	return err
+}
+
+// spillRun writes the sorted rows of the builder to disk as a run of the table and clears the builder.
+func (s *sortSpill) spillRun(key flux.GroupKey, builder execute.TableBuilder) error {
+	run, err := s.spiller.SpillBuilder(builder)
+	if err != nil {
+		return err
+	}
+	builder.ClearData()
+	var runs []flux.Table
+	if v, ok := s.runs.Lookup(key); ok {
+		runs = v.([]flux.Table)
+	}
+	s.runs.Set(key, append(runs, run))
+	return nil
+}
+
+// Table returns the table for the key, merging its runs if it was spilled.
+// A table in memory is spilled instead of copied when there is not enough memory left to copy it.
+func (s *sortSpill) Table(key flux.GroupKey) (flux.Table, error) {
+	if _, ok := s.runs.Lookup(key); !ok {
+		builder, _ := s.builders.TableBuilder(key)
+		if s.spiller.ShouldSpill(execute.TableSize(builder.NRows(), builder.Cols())) {
+			if err := s.spillRun(key, builder); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if v, ok := s.runs.Lookup(key); ok {
+		return s.spiller.Merge(key, v.([]flux.Table), s.cols, s.desc)
+	}
+	return s.DataCache.Table(key)
+}
+
+func (s *sortSpill) DiscardTable(key flux.GroupKey) {
+	s.runs.Delete(key)
+	s.DataCache.DiscardTable(key)
+}
+
+func (s *sortSpill) ExpireTable(key flux.GroupKey) {
+	s.runs.Delete(key)
+	s.DataCache.ExpireTable(key)
+}
+
+// SortFilterRule filters the rows of a table before sorting them when that is cheaper than
+// filtering the sorted rows. A filter does not change the order of the rows it keeps,
+// so either order produces the same tables.
+//
+//     filter            sort
+//       |                |
+//      sort     ==>    filter
+//       |                |
+//       W                W
+type SortFilterRule struct{}
+
+func (SortFilterRule) Name() string {
+	return "SortFilterRule"
+}
+
+func (SortFilterRule) Pattern() plan.Pattern {
+	return plan.Pat(FilterKind, plan.Pat(SortKind, plan.Any()))
+}
+
+func (SortFilterRule) Rewrite(filterNode plan.Node) (plan.Node, bool, error) {
+	sortNode := filterNode.Predecessors()[0]
+	if len(sortNode.Successors()) != 1 {
+		// The sorted rows are used by other nodes.
+		return filterNode, false, nil
+	}
+	filterSpec, ok := filterNode.ProcedureSpec().(plan.PhysicalProcedureSpec)
+	if !ok {
+		return filterNode, false, nil
+	}
+	sortSpec, ok := sortNode.ProcedureSpec().(plan.PhysicalProcedureSpec)
+	if !ok {
+		return filterNode, false, nil
+	}
+
+	_, in := plan.EstimateCost(sortNode.Predecessors()[0])
+	inStats := []plan.Statistics{in}
+	sortFirst, _ := plan.ChainCost(inStats, sortSpec, filterSpec)
+	filterFirst, _ := plan.ChainCost(inStats, filterSpec, sortSpec)
+	if !filterFirst.Less(sortFirst) {
+		return filterNode, false, nil
+	}
+
+	newNode, err := plan.SwapPlanNodes(filterNode, sortNode)
+	if err != nil {
+		return nil, false, err
+	}
+	return newNode, true, nil
+}
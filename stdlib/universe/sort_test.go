@@ -6,7 +6,12 @@ import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/generate"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 )
 
@@ -406,3 +411,83 @@ func TestSort_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestSortFilterRule(t *testing.T) {
+	var (
+		from   = &influxdb.FromProcedureSpec{}
+		empty  = &generate.FromGeneratorProcedureSpec{Count: 0}
+		sort   = &universe.SortProcedureSpec{Columns: []string{"_value"}}
+		count  = &universe.CountProcedureSpec{}
+		filter = &universe.FilterProcedureSpec{
+			Fn: &semantic.FunctionExpression{
+				Block: &semantic.FunctionBlock{
+					Body: &semantic.IdentifierExpression{
+						Name: "foo",
+					},
+				},
+			},
+		}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name: "sort filter",
+			// from -> sort -> filter => from -> filter -> sort
+			Rules: []plan.Rule{universe.SortFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("filter", filter),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("filter_copy", filter),
+					plan.CreatePhysicalNode("sort", sort),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+		},
+		{
+			Name: "sorted rows used twice",
+			// from -> sort -> filter
+			//            \-> count
+			Rules: []plan.Rule{universe.SortFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("filter", filter),
+					plan.CreatePhysicalNode("count", count),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {1, 3}},
+			},
+			NoChange: true,
+		},
+		{
+			Name: "no rows",
+			// Filtering first is not cheaper when there are no rows to sort.
+			Rules: []plan.Rule{universe.SortFilterRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", empty),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("filter", filter),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package universe_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+)
+
+// spillData generates a csv with two fields for each of several tags.
+// The values are shuffled so that sorting has to reorder every table.
+func spillData(tags, rows int) string {
+	var buf strings.Builder
+	buf.WriteString("#datatype,string,long,dateTime:RFC3339,string,string,double\n")
+	buf.WriteString("#group,false,false,false,true,true,false\n")
+	buf.WriteString("#default,_result,,,,,\n")
+	buf.WriteString(",result,table,_time,_field,t0,_value\n")
+	table := 0
+	for _, field := range []string{"f0", "f1"} {
+		for tag := 0; tag < tags; tag++ {
+			for i := 0; i < rows; i++ {
+				ts := time.Unix(int64(i), 0).UTC().Format(time.RFC3339)
+				v := (i * 7919) % rows
+				fmt.Fprintf(&buf, ",,%d,%s,%s,t%d,%d\n", table, ts, field, tag, v)
+			}
+			table++
+		}
+	}
+	return buf.String()
+}
+
+// runSpillQuery runs the script and returns its sorted tables
+// along with the maximum memory allocated by the query.
+func runSpillQuery(t *testing.T, script string, limit int64, deps execute.Dependencies) ([]*executetest.Table, int64, error) {
+	t.Helper()
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	program.SetExecutorDependencies(deps)
+	alloc := &memory.Allocator{}
+	if limit > 0 {
+		alloc.Limit = &limit
+	}
+	q, err := program.Start(context.Background(), alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	var tables []*executetest.Table
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			cb, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, cb)
+			return nil
+		}); err != nil {
+			q.Cancel()
+			for range q.Results() {
+			}
+			return nil, 0, err
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		return nil, 0, err
+	}
+	sort.Sort(executetest.SortedTables(tables))
+	return tables, alloc.MaxAllocated(), nil
+}
+
+// sortRows orders the rows of each table for the transformations
+// that do not guarantee an output row order.
+func sortRows(tables []*executetest.Table) {
+	for _, tbl := range tables {
+		sort.Slice(tbl.Data, func(i, j int) bool {
+			return fmt.Sprint(tbl.Data[i]) < fmt.Sprint(tbl.Data[j])
+		})
+	}
+}
+
+func TestSpill(t *testing.T) {
+	testCases := []struct {
+		name    string
+		tags    int
+		rows    int
+		script  string
+		ordered bool
+	}{
+		{
+			name:    "sort",
+			tags:    8,
+			rows:    4000,
+			script:  `csv.from(csv: data) |> sort(columns: ["_value"])`,
+			ordered: true,
+		},
+		{
+			name:   "pivot",
+			tags:   8,
+			rows:   4000,
+			script: `csv.from(csv: data) |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")`,
+		},
+		{
+			name: "join",
+			// Each joined table must fit in memory by itself.
+			tags:   8,
+			rows:   1000,
+			script: `join(tables: {a: csv.from(csv: data), b: csv.from(csv: data)}, on: ["_time", "_field", "t0"])`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data := spillData(tc.tags, tc.rows)
+			script := fmt.Sprintf("import \"csv\"\ndata = %q\n%s", data, tc.script)
+			want, max, err := runSpillQuery(t, script, 0, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.ordered {
+				sortRows(want)
+			}
+
+			const limit = 512 * 1024
+			if max <= limit {
+				t.Fatalf("query does not exceed the memory limit without spilling, it allocated %d bytes", max)
+			}
+
+			dir, err := ioutil.TempDir("", "spill_test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			got, _, err := runSpillQuery(t, script, limit, execute.Dependencies{
+				execute.SpillConfigKey: &execute.SpillConfig{Dir: dir},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.ordered {
+				sortRows(got)
+			}
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+			}
+			if files, err := ioutil.ReadDir(dir); err != nil {
+				t.Fatal(err)
+			} else if len(files) != 0 {
+				t.Errorf("spill files were not removed, found %d files", len(files))
+			}
+		})
+	}
+}
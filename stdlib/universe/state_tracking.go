@@ -65,7 +65,7 @@ func createStateTrackingOpSpec(args flux.Arguments, a *flux.Administration) (flu
 
 	spec := &StateTrackingOpSpec{
 		Fn:           fn,
-		DurationUnit: flux.Duration(time.Second),
+		DurationUnit: flux.ConvertDuration(time.Second),
 	}
 
 	if label, ok, err := args.GetString("countColumn"); err != nil {
@@ -81,6 +81,9 @@ func createStateTrackingOpSpec(args flux.Arguments, a *flux.Administration) (flu
 	if unit, ok, err := args.GetDuration("durationUnit"); err != nil {
 		return nil, err
 	} else if ok {
+		if unit.Months() != 0 {
+			return nil, fmt.Errorf("state tracking duration unit cannot have a month component: %v", unit)
+		}
 		spec.DurationUnit = unit
 	}
 	if label, ok, err := args.GetString("timeColumn"); err != nil {
@@ -91,7 +94,7 @@ func createStateTrackingOpSpec(args flux.Arguments, a *flux.Administration) (flu
 		spec.TimeColumn = execute.DefaultTimeColLabel
 	}
 
-	if spec.DurationColumn != "" && spec.DurationUnit <= 0 {
+	if spec.DurationColumn != "" && !spec.DurationUnit.IsPositive() {
 		return nil, errors.New("state tracking duration unit must be greater than zero")
 	}
 	return spec, nil
@@ -184,7 +187,7 @@ func NewStateTrackingTransformation(d execute.Dataset, cache execute.TableBuilde
 		fn:             fn,
 		countColumn:    spec.CountColumn,
 		durationColumn: spec.DurationColumn,
-		durationUnit:   int64(spec.DurationUnit),
+		durationUnit:   int64(spec.DurationUnit.Duration()),
 		timeCol:        spec.TimeCol,
 	}, nil
 }
@@ -32,7 +32,7 @@ func TestStateTracking_NewQuery(t *testing.T) {
 						ID: "range1",
 						Spec: &universe.RangeOpSpec{
 							Start: flux.Time{
-								Relative:   -1 * time.Hour,
+								Relative:   flux.ConvertDuration(-1 * time.Hour),
 								IsRelative: true,
 							},
 							Stop:        flux.Now,
@@ -46,7 +46,7 @@ func TestStateTracking_NewQuery(t *testing.T) {
 						Spec: &universe.StateTrackingOpSpec{
 							CountColumn:    "stateCount",
 							DurationColumn: "",
-							DurationUnit:   flux.Duration(time.Second),
+							DurationUnit:   flux.ConvertDuration(time.Second),
 							TimeColumn:     "_time",
 							Fn: &semantic.FunctionExpression{
 								Block: &semantic.FunctionBlock{
@@ -70,6 +70,11 @@ func TestStateTracking_NewQuery(t *testing.T) {
 			Raw:     `from(bucket:"mydb") |> range(start:-1h) |> stateCount(fn: (r) => true, timeColumn: "err")`,
 			WantErr: true,
 		},
+		{
+			Name:    "duration unit with months",
+			Raw:     `from(bucket:"mydb") |> stateDuration(fn: (r) => true, unit: 1mo)`,
+			WantErr: true,
+		},
 		{
 			Name: "from duration",
 			Raw:  `from(bucket:"mydb") |> stateDuration(fn: (r) => true, timeColumn: "ts")`,
@@ -86,7 +91,7 @@ func TestStateTracking_NewQuery(t *testing.T) {
 						Spec: &universe.StateTrackingOpSpec{
 							CountColumn:    "",
 							DurationColumn: "stateDuration",
-							DurationUnit:   flux.Duration(time.Second),
+							DurationUnit:   flux.ConvertDuration(time.Second),
 							TimeColumn:     "ts",
 							Fn: &semantic.FunctionExpression{
 								Block: &semantic.FunctionBlock{
@@ -122,7 +127,7 @@ func TestStateTrackingOperation_Marshaling(t *testing.T) {
 		Spec: &universe.StateTrackingOpSpec{
 			CountColumn:    "c",
 			DurationColumn: "d",
-			DurationUnit:   flux.Duration(time.Minute),
+			DurationUnit:   flux.ConvertDuration(time.Minute),
 			TimeColumn:     "t",
 		},
 	}
@@ -157,7 +162,7 @@ func TestStateTracking_Process(t *testing.T) {
 			name: "only duration",
 			spec: &universe.StateTrackingProcedureSpec{
 				DurationColumn: "duration",
-				DurationUnit:   1,
+				DurationUnit:   flux.ConvertDuration(1),
 				Fn:             gt5,
 				TimeCol:        "_time",
 			},
@@ -195,7 +200,7 @@ func TestStateTracking_Process(t *testing.T) {
 			name: "only duration, null timestamps",
 			spec: &universe.StateTrackingProcedureSpec{
 				DurationColumn: "duration",
-				DurationUnit:   1,
+				DurationUnit:   flux.ConvertDuration(1),
 				Fn:             gt5,
 				TimeCol:        "_time",
 			},
@@ -219,7 +224,7 @@ func TestStateTracking_Process(t *testing.T) {
 			name: "only duration, out of order timestamps",
 			spec: &universe.StateTrackingProcedureSpec{
 				DurationColumn: "duration",
-				DurationUnit:   1,
+				DurationUnit:   flux.ConvertDuration(1),
 				Fn:             gt5,
 				TimeCol:        "_time",
 			},
@@ -318,7 +323,7 @@ func TestStateTracking_Process(t *testing.T) {
 			spec: &universe.StateTrackingProcedureSpec{
 				CountColumn:    "count",
 				DurationColumn: "duration",
-				DurationUnit:   1,
+				DurationUnit:   flux.ConvertDuration(1),
 				Fn:             gt5,
 				TimeCol:        "_time",
 			},
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/parser"
@@ -196,7 +197,10 @@ func (c *intConv) Call(args values.Object) (values.Value, error) {
 	case semantic.Time:
 		i = int64(v.Time())
 	case semantic.Duration:
-		i = int64(v.Duration())
+		if v.Duration().Months() != 0 {
+			return nil, fmt.Errorf("cannot convert duration %v with a month component to int", v.Duration())
+		}
+		i = v.Duration().Nanoseconds()
 	default:
 		return nil, fmt.Errorf("cannot convert %v to int", v.Type())
 	}
@@ -287,7 +291,10 @@ func (c *uintConv) Call(args values.Object) (values.Value, error) {
 	case semantic.Time:
 		i = uint64(v.Time())
 	case semantic.Duration:
-		i = uint64(v.Duration())
+		if v.Duration().Months() != 0 {
+			return nil, fmt.Errorf("cannot convert duration %v with a month component to uint", v.Duration())
+		}
+		i = uint64(v.Duration().Nanoseconds())
 	default:
 		return nil, fmt.Errorf("cannot convert %v to uint", v.Type())
 	}
@@ -637,9 +644,9 @@ func (c *durationConv) Call(args values.Object) (values.Value, error) {
 		}
 		d = n
 	case semantic.Int:
-		d = values.Duration(v.Int())
+		d = values.ConvertDuration(time.Duration(v.Int()))
 	case semantic.UInt:
-		d = values.Duration(v.UInt())
+		d = values.ConvertDuration(time.Duration(v.UInt()))
 	default:
 		return nil, fmt.Errorf("cannot convert %v to duration", v.Type())
 	}
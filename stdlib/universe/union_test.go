@@ -32,7 +32,7 @@ func TestUnion_NewQuery(t *testing.T) {
 					ID: "range1",
 					Spec: &universe.RangeOpSpec{
 						Start: flux.Time{
-							Relative:   -1 * time.Hour,
+							Relative:   flux.ConvertDuration(-1 * time.Hour),
 							IsRelative: true,
 						},
 						Stop: flux.Time{
@@ -51,7 +51,7 @@ func TestUnion_NewQuery(t *testing.T) {
 					ID: "range3",
 					Spec: &universe.RangeOpSpec{
 						Start: flux.Time{
-							Relative:   -1 * time.Hour,
+							Relative:   flux.ConvertDuration(-1 * time.Hour),
 							IsRelative: true,
 						},
 						Stop: flux.Time{
@@ -91,7 +91,7 @@ func TestUnion_NewQuery(t *testing.T) {
 					ID: "range1",
 					Spec: &universe.RangeOpSpec{
 						Start: flux.Time{
-							Relative:   -1 * time.Hour,
+							Relative:   flux.ConvertDuration(-1 * time.Hour),
 							IsRelative: true,
 						},
 						Stop: flux.Time{
@@ -110,7 +110,7 @@ func TestUnion_NewQuery(t *testing.T) {
 					ID: "range3",
 					Spec: &universe.RangeOpSpec{
 						Start: flux.Time{
-							Relative:   -1 * time.Hour,
+							Relative:   flux.ConvertDuration(-1 * time.Hour),
 							IsRelative: true,
 						},
 						Stop: flux.Time{
@@ -129,7 +129,7 @@ func TestUnion_NewQuery(t *testing.T) {
 					ID: "range5",
 					Spec: &universe.RangeOpSpec{
 						Start: flux.Time{
-							Relative:   -1 * time.Hour,
+							Relative:   flux.ConvertDuration(-1 * time.Hour),
 							IsRelative: true,
 						},
 						Stop: flux.Time{
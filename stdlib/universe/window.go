@@ -3,6 +3,7 @@ package universe
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
@@ -22,9 +23,18 @@ type WindowOpSpec struct {
 	StopColumn  string        `json:"stopColumn"`
 	StartColumn string        `json:"startColumn"`
 	CreateEmpty bool          `json:"createEmpty"`
+
+	// Location is the time zone used to align the windows.
+	// When it is not set the location option of the query is used.
+	Location *flux.Location `json:"location,omitempty"`
+
+	// Intervals is a function, as returned by intervals,
+	// that produces the window bounds for the range of the query.
+	// It is not serialized since functions cannot be represented in JSON.
+	Intervals values.Function `json:"-"`
 }
 
-var infinityVar = values.NewDuration(math.MaxInt64)
+var infinityVar = values.NewDuration(values.ConvertDuration(math.MaxInt64))
 
 func init() {
 	windowSignature := flux.FunctionSignature(
@@ -36,6 +46,8 @@ func init() {
 			"startColumn": semantic.String,
 			"stopColumn":  semantic.String,
 			"createEmpty": semantic.Bool,
+			"intervals":   intervalsGeneratorPolyType,
+			"location":    flux.LocationPolyType,
 		},
 		nil,
 	)
@@ -74,8 +86,25 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 		spec.Offset = offset
 	}
 
-	if !everySet && !periodSet {
-		return nil, errors.New(`window function requires at least one of "every" or "period" to be set`)
+	if location, ok, err := args.GetObject("location"); err != nil {
+		return nil, err
+	} else if ok {
+		l, err := flux.LocationFromValue(location)
+		if err != nil {
+			return nil, err
+		}
+		spec.Location = &l
+	}
+
+	if intervals, ok, err := args.GetFunction("intervals"); err != nil {
+		return nil, err
+	} else if ok {
+		if !spec.Every.IsZero() || !spec.Period.IsZero() || !spec.Offset.IsZero() {
+			return nil, errors.New(`window function does not accept "every", "period" or "offset" when "intervals" is set`)
+		}
+		spec.Intervals = intervals
+	} else if !everySet && !periodSet {
+		return nil, errors.New(`window function requires at least one of "every", "period" or "intervals" to be set`)
 	}
 
 	if label, ok, err := args.GetString("timeColumn"); err != nil {
@@ -132,6 +161,7 @@ type WindowProcedureSpec struct {
 	StartColumn,
 	StopColumn string
 	CreateEmpty bool
+	Intervals   values.Function
 }
 
 func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -139,16 +169,22 @@ func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pro
 	if !ok {
 		return nil, fmt.Errorf("invalid spec type %T", qs)
 	}
+	location := pa.Location()
+	if s.Location != nil {
+		location = *s.Location
+	}
 	p := &WindowProcedureSpec{
 		Window: plan.WindowSpec{
-			Every:  s.Every,
-			Period: s.Period,
-			Offset: s.Offset,
+			Every:    s.Every,
+			Period:   s.Period,
+			Offset:   s.Offset,
+			Location: location,
 		},
 		TimeColumn:  s.TimeColumn,
 		StartColumn: s.StartColumn,
 		StopColumn:  s.StopColumn,
 		CreateEmpty: s.CreateEmpty,
+		Intervals:   s.Intervals,
 	}
 	return p, nil
 }
@@ -158,7 +194,7 @@ func (s *WindowProcedureSpec) Kind() plan.ProcedureKind {
 }
 func (s *WindowProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(WindowProcedureSpec)
-	ns.Window = s.Window
+	*ns = *s
 	return ns
 }
 
@@ -175,14 +211,40 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 		return nil, nil, errors.New("nil bounds passed to window")
 	}
 
+	location, err := s.Window.Location.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if s.Intervals != nil {
+		intervals, err := callIntervals(s.Intervals, *bounds, location)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate window intervals")
+		}
+		t := NewIntervalsWindowTransformation(
+			d,
+			cache,
+			*bounds,
+			intervals,
+			s.TimeColumn,
+			s.StartColumn,
+			s.StopColumn,
+			s.CreateEmpty,
+		)
+		return t, d, nil
+	}
+
+	w := execute.NewWindow(
+		execute.Duration(s.Window.Every),
+		execute.Duration(s.Window.Period),
+		execute.Duration(s.Window.Offset),
+	)
+	w.Location = location
 	t := NewFixedWindowTransformation(
 		d,
 		cache,
 		*bounds,
-		execute.NewWindow(
-			execute.Duration(s.Window.Every),
-			execute.Duration(s.Window.Period),
-			execute.Duration(s.Window.Offset)),
+		w,
 		s.TimeColumn,
 		s.StartColumn,
 		s.StopColumn,
@@ -198,6 +260,12 @@ type fixedWindowTransformation struct {
 	bounds    execute.Bounds
 	allBounds []execute.Bounds
 
+	// intervals is the sorted set of windows produced by an intervals function.
+	// When set it is used in place of w.
+	intervals []execute.Bounds
+	// maxIntervalLength is the length in nanoseconds of the longest interval.
+	maxIntervalLength int64
+
 	timeCol,
 	startCol,
 	stopCol string
@@ -232,6 +300,45 @@ func NewFixedWindowTransformation(
 	return t
 }
 
+// NewIntervalsWindowTransformation creates a window transformation
+// that groups rows into each of the given intervals.
+// The intervals must be sorted by their start time.
+func NewIntervalsWindowTransformation(
+	d execute.Dataset,
+	cache execute.TableBuilderCache,
+	bounds execute.Bounds,
+	intervals []execute.Bounds,
+	timeCol,
+	startCol,
+	stopCol string,
+	createEmpty bool,
+) execute.Transformation {
+	if intervals == nil {
+		intervals = []execute.Bounds{}
+	}
+	t := &fixedWindowTransformation{
+		d:           d,
+		cache:       cache,
+		bounds:      bounds,
+		intervals:   intervals,
+		timeCol:     timeCol,
+		startCol:    startCol,
+		stopCol:     stopCol,
+		createEmpty: createEmpty,
+	}
+	for _, b := range intervals {
+		if l := int64(b.Stop - b.Start); l > t.maxIntervalLength {
+			t.maxIntervalLength = l
+		}
+	}
+
+	if createEmpty {
+		t.generateWindowsWithinBounds()
+	}
+
+	return t
+}
+
 func (t *fixedWindowTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) (err error) {
 	panic("not implemented")
 }
@@ -367,6 +474,9 @@ func (t *fixedWindowTransformation) clipBounds(bs []execute.Bounds) {
 }
 
 func (t *fixedWindowTransformation) getWindowBounds(tm execute.Time) []execute.Bounds {
+	if t.intervals != nil {
+		return t.getIntervalBounds(tm)
+	}
 	if t.w.Every == infinityVar.Duration() {
 		return []execute.Bounds{t.bounds}
 	}
@@ -375,7 +485,41 @@ func (t *fixedWindowTransformation) getWindowBounds(tm execute.Time) []execute.B
 	return bs
 }
 
+// getIntervalBounds returns the clipped intervals that contain the time tm.
+func (t *fixedWindowTransformation) getIntervalBounds(tm execute.Time) []execute.Bounds {
+	if !t.bounds.Contains(tm) {
+		return nil
+	}
+	// Only intervals that start at or before tm and no earlier
+	// than the longest interval can contain tm.
+	end := sort.Search(len(t.intervals), func(i int) bool {
+		return t.intervals[i].Start > tm
+	})
+	var bs []execute.Bounds
+	for i := end - 1; i >= 0 && int64(tm-t.intervals[i].Start) <= t.maxIntervalLength; i-- {
+		if t.intervals[i].Contains(tm) {
+			bs = append(bs, t.intervals[i])
+		}
+	}
+	// Restore the order of the intervals.
+	for i, j := 0, len(bs)-1; i < j; i, j = i+1, j-1 {
+		bs[i], bs[j] = bs[j], bs[i]
+	}
+	t.clipBounds(bs)
+	return bs
+}
+
 func (t *fixedWindowTransformation) generateWindowsWithinBounds() {
+	if t.intervals != nil {
+		bs := make([]execute.Bounds, 0, len(t.intervals))
+		for _, b := range t.intervals {
+			if b = t.bounds.Intersect(b); !b.IsEmpty() {
+				bs = append(bs, b)
+			}
+		}
+		t.allBounds = bs
+		return
+	}
 	if t.w.Every == infinityVar.Duration() {
 		t.allBounds = []execute.Bounds{
 			{Start: execute.MinTime, Stop: execute.MaxTime},
@@ -34,9 +34,9 @@ func TestWindow_NewQuery(t *testing.T) {
 					{
 						ID: "window1",
 						Spec: &universe.WindowOpSpec{
-							Every:       flux.Duration(time.Hour),
-							Period:      flux.Duration(time.Hour),
-							Offset:      flux.Duration(time.Minute * -5),
+							Every:       flux.ConvertDuration(time.Hour),
+							Period:      flux.ConvertDuration(time.Hour),
+							Offset:      flux.ConvertDuration(time.Minute * -5),
 							TimeColumn:  execute.DefaultTimeColLabel,
 							StartColumn: execute.DefaultStartColLabel,
 							StopColumn:  execute.DefaultStopColLabel,
@@ -48,6 +48,51 @@ func TestWindow_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "window with location",
+			Raw: `option location = fixedZone(offset: -5h)
+from(bucket:"mybucket") |> window(every:1d, location: loadLocation(name: "America/Denver"))`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &universe.WindowOpSpec{
+							Every:       flux.ConvertDuration(24 * time.Hour),
+							Period:      flux.ConvertDuration(24 * time.Hour),
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+							Location:    &flux.Location{Zone: "America/Denver"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+				Location: flux.Location{Offset: -5 * time.Hour},
+			},
+		},
+		{
+			Name:    "window with invalid location",
+			Raw:     `from(bucket:"mybucket") |> window(every:1d, location: {zone: "", offset: 1mo})`,
+			WantErr: true,
+		},
+		{
+			Name:    "window with intervals and every",
+			Raw:     `from(bucket:"mybucket") |> window(every:1h, intervals: intervals(every:1h))`,
+			WantErr: true,
+		},
+		{
+			Name:    "window without every, period or intervals",
+			Raw:     `from(bucket:"mybucket") |> window(offset:1h)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -63,9 +108,9 @@ func TestWindowOperation_Marshaling(t *testing.T) {
 	op := &flux.Operation{
 		ID: "window",
 		Spec: &universe.WindowOpSpec{
-			Every:  flux.Duration(time.Minute),
-			Period: flux.Duration(time.Hour),
-			Offset: flux.Duration(30 * time.Minute),
+			Every:  flux.ConvertDuration(time.Minute),
+			Period: flux.ConvertDuration(time.Hour),
+			Offset: flux.ConvertDuration(30 * time.Minute),
 		},
 	}
 
@@ -79,9 +124,9 @@ func TestFixedWindow_PassThrough(t *testing.T) {
 			c,
 			execute.Bounds{},
 			execute.NewWindow(
-				execute.Duration(time.Minute),
-				execute.Duration(time.Minute),
-				0),
+				values.ConvertDuration(time.Minute),
+				values.ConvertDuration(time.Minute),
+				values.Duration{}),
 			execute.DefaultTimeColLabel,
 			execute.DefaultStartColLabel,
 			execute.DefaultStopColLabel,
@@ -141,9 +186,9 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use bounds and offset that is *not* aligned with the every/period durations of the window
 			bounds:      nonalignedBounds,
-			offset:      execute.Duration(10*time.Second + 10*time.Nanosecond),
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(time.Minute),
+			offset:      values.ConvertDuration(10*time.Second + 10*time.Nanosecond),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(time.Minute),
 			createEmpty: true,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -211,8 +256,8 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use bounds that are aligned with period and duration of window
 			bounds:      alignedBounds,
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(time.Minute),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(time.Minute),
 			createEmpty: true,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -299,9 +344,9 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use a time that is *not* aligned with the every/period durations of the window
 			bounds:      nonalignedBounds,
-			offset:      execute.Duration(time.Second*10 + time.Nanosecond*10),
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(2 * time.Minute),
+			offset:      values.ConvertDuration(time.Second*10 + time.Nanosecond*10),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(2 * time.Minute),
 			createEmpty: true,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -395,8 +440,8 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use a bounds that are aligned with the every/period durations of the window
 			bounds:      alignedBounds,
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(2 * time.Minute),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(2 * time.Minute),
 			createEmpty: true,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -490,9 +535,9 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use a time that is *not* aligned with the every/period durations of the window
 			bounds:      nonalignedBounds,
-			every:       execute.Duration(2 * time.Minute),
-			period:      execute.Duration(time.Minute),
-			offset:      execute.Duration(10*time.Second + 10*time.Nanosecond),
+			every:       values.ConvertDuration(2 * time.Minute),
+			period:      values.ConvertDuration(time.Minute),
+			offset:      values.ConvertDuration(10*time.Second + 10*time.Nanosecond),
 			createEmpty: true,
 			num:         24,
 			want: func(start execute.Time) []*executetest.Table {
@@ -542,8 +587,8 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use a time that is  aligned with the every/period durations of the window
 			bounds:      alignedBounds,
-			every:       execute.Duration(2 * time.Minute),
-			period:      execute.Duration(time.Minute),
+			every:       values.ConvertDuration(2 * time.Minute),
+			period:      values.ConvertDuration(time.Minute),
 			createEmpty: true,
 			num:         24,
 			want: func(start execute.Time) []*executetest.Table {
@@ -593,8 +638,8 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TInt},
 			// Use bounds that are aligned with the every/period durations of the window
 			bounds:      alignedBounds,
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(time.Minute),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(time.Minute),
 			createEmpty: true,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -664,8 +709,8 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TInt},
 			// Use bounds that are aligned with the every/period durations of the window
 			bounds:      alignedBounds,
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(time.Minute),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(time.Minute),
 			createEmpty: false,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -726,8 +771,8 @@ func TestFixedWindow_Process(t *testing.T) {
 		{
 			name:     "empty bounds start == stop",
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TInt},
-			every:    execute.Duration(time.Minute),
-			period:   execute.Duration(time.Minute),
+			every:    values.ConvertDuration(time.Minute),
+			period:   values.ConvertDuration(time.Minute),
 			num:      15,
 			bounds: execute.Bounds{
 				Start: execute.Time(time.Date(2017, 10, 10, 0, 0, 0, 0, time.UTC).UnixNano()),
@@ -742,9 +787,9 @@ func TestFixedWindow_Process(t *testing.T) {
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TFloat},
 			// Use bounds that are aligned with the every/period durations of the window
 			bounds:      alignedBounds,
-			every:       execute.Duration(time.Minute),
-			period:      execute.Duration(time.Minute),
-			offset:      execute.Duration(-15 * time.Second),
+			every:       values.ConvertDuration(time.Minute),
+			period:      values.ConvertDuration(time.Minute),
+			offset:      values.ConvertDuration(-15 * time.Second),
 			createEmpty: true,
 			num:         15,
 			want: func(start execute.Time) []*executetest.Table {
@@ -815,8 +860,8 @@ func TestFixedWindow_Process(t *testing.T) {
 		{
 			name:     "empty bounds start > stop",
 			valueCol: flux.ColMeta{Label: "_value", Type: flux.TInt},
-			every:    execute.Duration(time.Minute),
-			period:   execute.Duration(time.Minute),
+			every:    values.ConvertDuration(time.Minute),
+			period:   values.ConvertDuration(time.Minute),
 			num:      15,
 			bounds: execute.Bounds{
 				Start: execute.Time(time.Date(2017, 10, 10, 0, 0, 0, 0, time.UTC).UnixNano()),
@@ -902,6 +947,144 @@ func TestFixedWindow_Process(t *testing.T) {
 	}
 }
 
+func TestIntervalsWindow_Process(t *testing.T) {
+	start := execute.Time(time.Date(2017, 10, 10, 0, 0, 0, 0, time.UTC).UnixNano())
+	at := func(d time.Duration) execute.Time {
+		return start + execute.Time(d)
+	}
+	bounds := execute.Bounds{
+		Start: start,
+		Stop:  at(10 * time.Minute),
+	}
+	testCols := []flux.ColMeta{
+		{Label: "_start", Type: flux.TTime},
+		{Label: "_stop", Type: flux.TTime},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	testCases := []struct {
+		name        string
+		intervals   []execute.Bounds
+		createEmpty bool
+		want        []*executetest.Table
+	}{
+		{
+			name: "overlapping",
+			intervals: []execute.Bounds{
+				{Start: at(-5 * time.Minute), Stop: at(-time.Minute)},
+				{Start: start, Stop: at(3 * time.Minute)},
+				{Start: at(2 * time.Minute), Stop: at(5 * time.Minute)},
+				{Start: at(6*time.Minute + 30*time.Second), Stop: at(7 * time.Minute)},
+				{Start: at(8 * time.Minute), Stop: at(12 * time.Minute)},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: testCols,
+					Data: [][]interface{}{
+						{start, at(3 * time.Minute), start, 0.0},
+						{start, at(3 * time.Minute), at(time.Minute), 1.0},
+						{start, at(3 * time.Minute), at(2 * time.Minute), 2.0},
+					},
+				},
+				{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: testCols,
+					Data: [][]interface{}{
+						{at(2 * time.Minute), at(5 * time.Minute), at(2 * time.Minute), 2.0},
+						{at(2 * time.Minute), at(5 * time.Minute), at(3 * time.Minute), 3.0},
+						{at(2 * time.Minute), at(5 * time.Minute), at(4 * time.Minute), 4.0},
+					},
+				},
+				{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: testCols,
+					Data: [][]interface{}{
+						{at(8 * time.Minute), at(10 * time.Minute), at(8 * time.Minute), 8.0},
+						{at(8 * time.Minute), at(10 * time.Minute), at(9 * time.Minute), 9.0},
+					},
+				},
+			},
+		},
+		{
+			name: "create empty",
+			intervals: []execute.Bounds{
+				{Start: at(-5 * time.Minute), Stop: at(-time.Minute)},
+				{Start: at(4 * time.Minute), Stop: at(5 * time.Minute)},
+				{Start: at(6*time.Minute + 30*time.Second), Stop: at(7 * time.Minute)},
+			},
+			createEmpty: true,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: testCols,
+					Data: [][]interface{}{
+						{at(4 * time.Minute), at(5 * time.Minute), at(4 * time.Minute), 4.0},
+					},
+				},
+				newEmptyWindowTable(at(6*time.Minute+30*time.Second), at(7*time.Minute), testCols),
+			},
+		},
+		{
+			name:        "no intervals",
+			intervals:   []execute.Bounds{},
+			createEmpty: true,
+			want:        []*executetest.Table(nil),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+			fw := universe.NewIntervalsWindowTransformation(
+				d,
+				c,
+				bounds,
+				tc.intervals,
+				execute.DefaultTimeColLabel,
+				execute.DefaultStartColLabel,
+				execute.DefaultStopColLabel,
+				tc.createEmpty,
+			)
+
+			table0 := &executetest.Table{
+				ColMeta: testCols,
+			}
+			for i := 0; i < 10; i++ {
+				table0.Data = append(table0.Data, []interface{}{
+					bounds.Start,
+					bounds.Stop,
+					at(time.Duration(i) * time.Minute),
+					float64(i),
+				})
+			}
+
+			parentID := executetest.RandomDatasetID()
+			if err := fw.Process(parentID, table0); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+
+			sort.Sort(executetest.SortedTables(got))
+			sort.Sort(executetest.SortedTables(tc.want))
+
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
 func windowOp(id string) plan.Node {
 	return plan.CreatePhysicalNode(plan.NodeID(id), &universe.WindowProcedureSpec{})
 }
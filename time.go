@@ -1,8 +1,14 @@
 package flux
 
 import (
+	"fmt"
 	"math"
+	"sync"
 	"time"
+
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
 )
 
 var (
@@ -22,14 +28,20 @@ var (
 // To represent the now time you must set IsRelative to true.
 type Time struct {
 	IsRelative bool
-	Relative   time.Duration
+	Relative   Duration
 	Absolute   time.Time
 }
 
 // Time returns the time specified relative to now.
 func (t Time) Time(now time.Time) time.Time {
 	if t.IsRelative {
-		return now.Add(t.Relative)
+		if months := t.Relative.Months(); months != 0 {
+			// Months are added the same way windows add them, so a day past
+			// the end of the target month is clamped to its last day.
+			loc := now.Location()
+			now = values.ConvertTime(now).AddIn(values.MakeDuration(0, months), loc).Time().In(loc)
+		}
+		return now.Add(time.Duration(t.Relative.Nanoseconds()))
 	}
 	return t.Absolute
 }
@@ -41,19 +53,19 @@ func (t Time) IsZero() bool {
 func (t *Time) UnmarshalText(data []byte) error {
 	if len(data) == 0 {
 		t.Absolute = time.Time{}
-		t.Relative = 0
+		t.Relative = Duration{}
 		t.IsRelative = false
 		return nil
 	}
 
 	str := string(data)
 	if str == "now" {
-		t.Relative = 0
+		t.Relative = Duration{}
 		t.Absolute = time.Time{}
 		t.IsRelative = true
 		return nil
 	}
-	d, err := time.ParseDuration(str)
+	d, err := values.ParseDuration(str)
 	if err == nil {
 		t.Relative = d
 		t.Absolute = time.Time{}
@@ -66,13 +78,13 @@ func (t *Time) UnmarshalText(data []byte) error {
 	}
 	t.Absolute = ts.UTC()
 	t.IsRelative = false
-	t.Relative = 0
+	t.Relative = Duration{}
 	return nil
 }
 
 func (t Time) MarshalText() ([]byte, error) {
 	if t.IsRelative {
-		if t.Relative == 0 {
+		if t.Relative.IsZero() {
 			return []byte("now"), nil
 		}
 		return []byte(t.Relative.String()), nil
@@ -81,18 +93,133 @@ func (t Time) MarshalText() ([]byte, error) {
 }
 
 // Duration is a marshalable duration type.
-//TODO make this the real duration parsing not just time.ParseDuration
-type Duration time.Duration
+// It may include a calendar month component, see values.Duration.
+type Duration = values.Duration
+
+// ConvertDuration returns a Duration with the exact length of the time.Duration.
+func ConvertDuration(v time.Duration) Duration {
+	return values.ConvertDuration(v)
+}
+
+// Location is the time zone in which calendar boundaries, such as days and months, are computed.
+// The zero value is UTC.
+type Location struct {
+	// Zone is the name of a time zone in the IANA Time Zone database, such as "America/Denver".
+	// An empty zone is a fixed offset from UTC.
+	Zone string `json:"zone,omitempty"`
+	// Offset is the offset from UTC of a fixed zone.
+	Offset time.Duration `json:"offset,omitempty"`
+}
+
+// LocationPolyType is the type of a location value, such as the location option.
+var LocationPolyType = semantic.NewObjectPolyType(
+	map[string]semantic.PolyType{
+		"zone":   semantic.String,
+		"offset": semantic.Duration,
+	},
+	semantic.LabelSet{"zone", "offset"},
+	semantic.LabelSet{"zone", "offset"},
+)
+
+// LoadLocation returns the location with the given IANA time zone name.
+// The names "" and "UTC" are both UTC.
+func LoadLocation(name string) (Location, error) {
+	if name == "" || name == "UTC" {
+		return Location{}, nil
+	}
+	if _, err := loadLocation(name); err != nil {
+		return Location{}, err
+	}
+	return Location{Zone: name}, nil
+}
+
+// locations caches time zones that have been loaded from the time zone database.
+var locations sync.Map
 
-func (d *Duration) UnmarshalText(data []byte) error {
-	dur, err := time.ParseDuration(string(data))
+// loadLocation returns the named time zone, reading the time zone database only once for each name.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	*d = Duration(dur)
-	return nil
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// FixedZone returns the location that is always offset from UTC by the given duration.
+func FixedZone(offset time.Duration) (Location, error) {
+	if offset%time.Second != 0 {
+		return Location{}, fmt.Errorf("location offset %v must be a whole number of seconds", offset)
+	}
+	if offset <= -24*time.Hour || offset >= 24*time.Hour {
+		return Location{}, fmt.Errorf("location offset %v must be less than 24h from UTC", offset)
+	}
+	return Location{Offset: offset}, nil
 }
 
-func (d Duration) MarshalText() ([]byte, error) {
-	return []byte(time.Duration(d).String()), nil
+// Load returns the time.Location described by l.
+func (l Location) Load() (*time.Location, error) {
+	if l.Zone != "" {
+		if l.Offset != 0 {
+			return nil, fmt.Errorf("location %q cannot also have an offset", l.Zone)
+		}
+		return loadLocation(l.Zone)
+	}
+	if l.Offset == 0 {
+		return time.UTC, nil
+	}
+	return time.FixedZone(l.String(), int(l.Offset/time.Second)), nil
+}
+
+// IsUTC reports whether l is UTC.
+func (l Location) IsUTC() bool {
+	return l.Zone == "" && l.Offset == 0
+}
+
+func (l Location) String() string {
+	if l.Zone != "" {
+		return l.Zone
+	}
+	if l.Offset == 0 {
+		return "UTC"
+	}
+	sign, offset := '+', l.Offset
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	hours, minutes := offset/time.Hour, (offset%time.Hour)/time.Minute
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, hours, minutes)
+}
+
+// Value returns the Flux object that represents l.
+func (l Location) Value() values.Object {
+	return values.NewObjectWithValues(map[string]values.Value{
+		"zone":   values.NewString(l.Zone),
+		"offset": values.NewDuration(values.ConvertDuration(l.Offset)),
+	})
+}
+
+// LocationFromValue returns the Location represented by a Flux location object.
+func LocationFromValue(v values.Value) (Location, error) {
+	if v.Type().Nature() != semantic.Object {
+		return Location{}, fmt.Errorf("location must be an object, got %v", v.Type())
+	}
+	zone, zoneOK := v.Object().Get("zone")
+	offset, offsetOK := v.Object().Get("offset")
+	if !zoneOK || !offsetOK || zone.Type() != semantic.String || offset.Type() != semantic.Duration {
+		return Location{}, errors.New(`location must have a "zone" string and an "offset" duration`)
+	}
+	if offset.Duration().Months() != 0 {
+		return Location{}, fmt.Errorf("location offset %v must not have a month component", offset.Duration())
+	}
+	if zone.Str() != "" {
+		if !offset.Duration().IsZero() {
+			return Location{}, fmt.Errorf("location %q cannot also have an offset", zone.Str())
+		}
+		return LoadLocation(zone.Str())
+	}
+	return FixedZone(time.Duration(offset.Duration().Nanoseconds()))
 }
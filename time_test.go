@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/values"
 )
 
 func TestTime_MarshalText(t *testing.T) {
@@ -22,14 +23,14 @@ func TestTime_MarshalText(t *testing.T) {
 		},
 		{
 			ts: flux.Time{
-				Relative:   -time.Minute,
+				Relative:   flux.ConvertDuration(-time.Minute),
 				IsRelative: true,
 			},
 			want: "-1m0s",
 		},
 		{
 			ts: flux.Time{
-				Relative:   time.Minute,
+				Relative:   flux.ConvertDuration(time.Minute),
 				IsRelative: true,
 			},
 			want: "1m0s",
@@ -82,14 +83,21 @@ func TestTime_UnmarshalText(t *testing.T) {
 		{
 			s: "-1m0s",
 			want: flux.Time{
-				Relative:   -time.Minute,
+				Relative:   flux.ConvertDuration(-time.Minute),
 				IsRelative: true,
 			},
 		},
 		{
 			s: "1m0s",
 			want: flux.Time{
-				Relative:   time.Minute,
+				Relative:   flux.ConvertDuration(time.Minute),
+				IsRelative: true,
+			},
+		},
+		{
+			s: "-1mo",
+			want: flux.Time{
+				Relative:   values.MakeDuration(0, -1),
 				IsRelative: true,
 			},
 		},
@@ -126,3 +134,148 @@ func TestTime_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestTime_Time(t *testing.T) {
+	now := time.Date(2019, 3, 31, 12, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name string
+		ts   flux.Time
+		want time.Time
+	}{
+		{
+			name: "now",
+			ts:   flux.Now,
+			want: now,
+		},
+		{
+			name: "relative duration",
+			ts: flux.Time{
+				Relative:   flux.ConvertDuration(-time.Hour),
+				IsRelative: true,
+			},
+			want: time.Date(2019, 3, 31, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "relative months",
+			ts: flux.Time{
+				Relative:   values.MakeDuration(0, -12),
+				IsRelative: true,
+			},
+			want: time.Date(2018, 3, 31, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "relative month past the end of the month",
+			ts: flux.Time{
+				Relative:   values.MakeDuration(0, -1),
+				IsRelative: true,
+			},
+			want: time.Date(2019, 2, 28, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "relative month and day past the end of the month",
+			ts: flux.Time{
+				Relative:   values.MakeDuration(-int64(24*time.Hour), -1),
+				IsRelative: true,
+			},
+			want: time.Date(2019, 2, 27, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "absolute",
+			ts: flux.Time{
+				Absolute: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ts.Time(now); !got.Equal(tt.want) {
+				t.Fatalf("unexpected time -want/+got\n\t- %s\n\t+ %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLocationFromValue(t *testing.T) {
+	testCases := []struct {
+		name    string
+		zone    string
+		offset  values.Duration
+		want    flux.Location
+		str     string
+		wantErr bool
+	}{
+		{
+			name: "utc",
+			str:  "UTC",
+		},
+		{
+			name: "utc zone",
+			zone: "UTC",
+			str:  "UTC",
+		},
+		{
+			name: "named zone",
+			zone: "America/Denver",
+			want: flux.Location{Zone: "America/Denver"},
+			str:  "America/Denver",
+		},
+		{
+			name:   "fixed zone",
+			offset: values.ConvertDuration(-(5*time.Hour + 30*time.Minute)),
+			want:   flux.Location{Offset: -(5*time.Hour + 30*time.Minute)},
+			str:    "UTC-05:30",
+		},
+		{
+			name:    "unknown zone",
+			zone:    "Nowhere/Special",
+			wantErr: true,
+		},
+		{
+			name:    "zone with offset",
+			zone:    "America/Denver",
+			offset:  values.ConvertDuration(time.Hour),
+			wantErr: true,
+		},
+		{
+			name:    "offset with months",
+			offset:  values.MakeDuration(0, 1),
+			wantErr: true,
+		},
+		{
+			name:    "offset too large",
+			offset:  values.ConvertDuration(25 * time.Hour),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := flux.LocationFromValue(values.NewObjectWithValues(map[string]values.Value{
+				"zone":   values.NewString(tc.zone),
+				"offset": values.NewDuration(tc.offset),
+			}))
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected location -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+			if got.String() != tc.str {
+				t.Errorf("unexpected location string: want %q got %q", tc.str, got.String())
+			}
+			loc, err := got.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loc.String() != tc.str {
+				t.Errorf("unexpected loaded location: want %q got %q", tc.str, loc.String())
+			}
+		})
+	}
+}
@@ -1,11 +1,20 @@
 package values
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type Time int64
-type Duration int64
+
+// Duration is a length of time made of a number of calendar months and a number of nanoseconds.
+// Months do not have a fixed length, so the month component is applied using calendar arithmetic.
+type Duration struct {
+	months int64
+	nsecs  int64
+}
 
 const (
 	fixedWidthTimeFmt = "2006-01-02T15:04:05.000000000Z"
@@ -15,40 +24,118 @@ func ConvertTime(t time.Time) Time {
 	return Time(t.UnixNano())
 }
 
+// Round rounds t to the nearest multiple of d.
+// Durations with a month component round to calendar month boundaries.
 func (t Time) Round(d Duration) Time {
-	if d <= 0 {
+	if !d.IsPositive() {
 		return t
 	}
+	if d.months != 0 {
+		lower := t.Truncate(d)
+		upper := lower.Add(d)
+		if t-lower < upper-t {
+			return lower
+		}
+		return upper
+	}
 	r := t.Remainder(d)
-	if lessThanHalf(r, d) {
+	if lessThanHalf(r, d.nsecs) {
 		return t - Time(r)
 	}
-	return t + Time(d-r)
+	return t + Time(d.nsecs-r)
 }
 
+// Truncate rounds t down to a multiple of d.
+// Durations with a month component truncate to calendar month boundaries
+// counted from the Unix epoch and ignore the nanosecond component.
 func (t Time) Truncate(d Duration) Time {
-	if d <= 0 {
+	return t.TruncateIn(d, time.UTC)
+}
+
+// TruncateIn rounds t down to a multiple of d in the wall clock time of loc.
+// Durations that are a whole number of days truncate to local midnight,
+// even when the UTC offset of loc changes during the day.
+// A nil location is UTC.
+func (t Time) TruncateIn(d Duration, loc *time.Location) Time {
+	if !d.IsPositive() {
 		return t
 	}
-	r := t.Remainder(d)
-	return t - Time(r)
+	if loc == nil {
+		loc = time.UTC
+	}
+	tm := t.Time().In(loc)
+	if d.months != 0 {
+		months := int64(tm.Year()-1970)*12 + int64(tm.Month()-1)
+		months = floorDiv(months, d.months) * d.months
+		return ConvertTime(time.Date(1970, time.Month(months+1), 1, 0, 0, 0, 0, loc))
+	}
+	_, offset := tm.Zone()
+	wall := t + Time(offset)*Time(time.Second)
+	wall -= Time(wall.Remainder(d))
+	if d.nsecs%int64(day) != 0 {
+		return wall - Time(offset)*Time(time.Second)
+	}
+	w := wall.Time()
+	return ConvertTime(time.Date(w.Year(), w.Month(), w.Day(), 0, 0, 0, 0, loc))
 }
 
+// Add returns the time t+d.
+// The month component of d is added using calendar arithmetic
+// before the nanosecond component is added.
+// When the day of the month does not exist in the resulting month
+// the time is moved to the last day of that month.
 func (t Time) Add(d Duration) Time {
-	return t + Time(d)
+	return t.AddIn(d, time.UTC)
+}
+
+// AddIn returns the time t+d using the wall clock time of loc.
+// Months and whole days are added to the local date and keep the local time of day,
+// so adding a day across a daylight saving time transition moves
+// the time by 23 or 25 hours. The remaining nanoseconds are added as elapsed time.
+// A nil location is UTC.
+func (t Time) AddIn(d Duration, loc *time.Location) Time {
+	days, nsecs := d.nsecs/int64(day), d.nsecs%int64(day)
+	if d.months == 0 && days == 0 {
+		return t + Time(nsecs)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	tm := t.Time().In(loc)
+	year, month, mday := tm.Date()
+	if d.months != 0 {
+		// The first of the month is always valid, so use it to find the target month.
+		first := time.Date(year, month+time.Month(d.months), 1, 0, 0, 0, 0, loc)
+		if last := first.AddDate(0, 1, -1).Day(); mday > last {
+			mday = last
+		}
+		year, month = first.Year(), first.Month()
+	}
+	hour, min, sec := tm.Clock()
+	t = ConvertTime(time.Date(year, month, mday+int(days), hour, min, sec, tm.Nanosecond(), loc))
+	return t + Time(nsecs)
 }
 
-// remainder divides t by d and returns the remainder.
-func (t Time) Remainder(d Duration) (r Duration) {
-	return Duration(int64(t) % int64(d))
+// Remainder divides t by the nanosecond component of d and returns the remainder.
+func (t Time) Remainder(d Duration) (r int64) {
+	return int64(t) % d.nsecs
 }
 
 // lessThanHalf reports whether x+x < y but avoids overflow,
-// assuming x and y are both positive (Duration is signed).
-func lessThanHalf(x, y Duration) bool {
+// assuming x and y are both positive.
+func lessThanHalf(x, y int64) bool {
 	return uint64(x)+uint64(x) < uint64(y)
 }
 
+// floorDiv divides n by d rounding towards negative infinity.
+func floorDiv(n, d int64) int64 {
+	q := n / d
+	if (n%d != 0) && ((n < 0) != (d < 0)) {
+		q--
+	}
+	return q
+}
+
 func (t Time) String() string {
 	return t.Time().Format(fixedWidthTimeFmt)
 }
@@ -65,16 +152,173 @@ func (t Time) Time() time.Time {
 	return time.Unix(0, int64(t)).UTC()
 }
 
+const (
+	day = 24 * time.Hour
+
+	// averageMonth is the average length of a month in the Gregorian calendar.
+	averageMonth = time.Duration(365.2425 * 24 * float64(time.Hour) / 12)
+)
+
+// ConvertDuration returns a Duration with the exact length of the time.Duration.
+func ConvertDuration(v time.Duration) Duration {
+	return Duration{nsecs: int64(v)}
+}
+
+// MakeDuration returns a Duration with the given number of nanoseconds and calendar months.
+// Both components must have the same sign.
+func MakeDuration(nsecs, months int64) Duration {
+	return Duration{
+		months: months,
+		nsecs:  nsecs,
+	}
+}
+
+// Months returns the calendar month component of the duration.
+func (d Duration) Months() int64 {
+	return d.months
+}
+
+// Nanoseconds returns the nanosecond component of the duration.
+func (d Duration) Nanoseconds() int64 {
+	return d.nsecs
+}
+
+// IsZero reports whether the duration has no length.
+func (d Duration) IsZero() bool {
+	return d.months == 0 && d.nsecs == 0
+}
+
+// IsPositive reports whether the duration moves time forward.
+func (d Duration) IsPositive() bool {
+	return d.months > 0 || (d.months == 0 && d.nsecs > 0)
+}
+
+// IsNegative reports whether the duration moves time backward.
+func (d Duration) IsNegative() bool {
+	return d.months < 0 || (d.months == 0 && d.nsecs < 0)
+}
+
+// Neg returns the duration with the opposite sign.
+func (d Duration) Neg() Duration {
+	return Duration{
+		months: -d.months,
+		nsecs:  -d.nsecs,
+	}
+}
+
+// Mul returns the duration multiplied by n.
+func (d Duration) Mul(n int64) Duration {
+	return Duration{
+		months: d.months * n,
+		nsecs:  d.nsecs * n,
+	}
+}
+
+// Equal reports whether both durations have the same months and nanoseconds.
+func (d Duration) Equal(o Duration) bool {
+	return d == o
+}
+
+// Duration returns the duration as a time.Duration.
+// Months do not have a fixed length so they are approximated by their average length.
 func (d Duration) Duration() time.Duration {
-	return time.Duration(d)
+	return time.Duration(d.months)*averageMonth + time.Duration(d.nsecs)
 }
+
 func (d Duration) String() string {
-	return time.Duration(d).String()
+	if d.months == 0 {
+		return time.Duration(d.nsecs).String()
+	}
+	if d.IsNegative() {
+		return "-" + d.Neg().String()
+	}
+	var b strings.Builder
+	if years := d.months / 12; years != 0 {
+		b.WriteString(strconv.FormatInt(years, 10))
+		b.WriteString("y")
+	}
+	if months := d.months % 12; months != 0 {
+		b.WriteString(strconv.FormatInt(months, 10))
+		b.WriteString("mo")
+	}
+	if d.nsecs != 0 {
+		b.WriteString(time.Duration(d.nsecs).String())
+	}
+	return b.String()
 }
-func ParseDuration(s string) (Duration, error) {
-	d, err := time.ParseDuration(s)
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Duration) UnmarshalText(data []byte) error {
+	dur, err := ParseDuration(string(data))
 	if err != nil {
-		return 0, err
+		return err
+	}
+	*d = dur
+	return nil
+}
+
+// ParseDuration parses a duration string such as "1h30m" or "1y2mo".
+// The y and mo units are calendar units and are kept as months,
+// the w and d units are fixed lengths of 7 and 1 days,
+// all other units are those accepted by time.ParseDuration.
+func ParseDuration(s string) (Duration, error) {
+	orig := s
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "" {
+		return Duration{}, fmt.Errorf("invalid duration %q", orig)
+	}
+
+	var d Duration
+	for s != "" {
+		i := 0
+		for i < len(s) && (s[i] == '.' || '0' <= s[i] && s[i] <= '9') {
+			i++
+		}
+		if i == 0 {
+			return Duration{}, fmt.Errorf("invalid duration %q", orig)
+		}
+		mag := s[:i]
+		s = s[i:]
+		i = 0
+		for i < len(s) && s[i] != '.' && !('0' <= s[i] && s[i] <= '9') {
+			i++
+		}
+		unit := s[:i]
+		s = s[i:]
+
+		switch unit {
+		case "y", "mo", "w", "d":
+			n, err := strconv.ParseInt(mag, 10, 64)
+			if err != nil {
+				return Duration{}, fmt.Errorf("invalid duration %q", orig)
+			}
+			switch unit {
+			case "y":
+				d.months += n * 12
+			case "mo":
+				d.months += n
+			case "w":
+				d.nsecs += n * int64(7*24*time.Hour)
+			case "d":
+				d.nsecs += n * int64(24*time.Hour)
+			}
+		default:
+			v, err := time.ParseDuration(mag + unit)
+			if err != nil {
+				return Duration{}, err
+			}
+			d.nsecs += int64(v)
+		}
+	}
+	if neg {
+		d = d.Neg()
 	}
-	return Duration(d), nil
+	return d, nil
 }
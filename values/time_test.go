@@ -15,22 +15,22 @@ func TestTime_Round(t *testing.T) {
 	}{
 		{
 			ts:   values.Time(time.Second + 500*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(2 * time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 501*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(2 * time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 499*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 0*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 	} {
@@ -50,27 +50,27 @@ func TestTime_Truncate(t *testing.T) {
 	}{
 		{
 			ts:   values.Time(time.Second + 500*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 501*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 499*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 0*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 		{
 			ts:   values.Time(time.Second + 999*time.Millisecond),
-			d:    values.Duration(time.Second),
+			d:    values.ConvertDuration(time.Second),
 			want: values.Time(time.Second),
 		},
 	} {
@@ -81,3 +81,267 @@ func TestTime_Truncate(t *testing.T) {
 		})
 	}
 }
+
+func TestTime_Add(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		ts   string
+		d    values.Duration
+		want string
+	}{
+		{
+			name: "nanoseconds",
+			ts:   "2019-01-31T00:00:00Z",
+			d:    values.ConvertDuration(time.Hour),
+			want: "2019-01-31T01:00:00Z",
+		},
+		{
+			name: "month",
+			ts:   "2019-01-15T00:00:00Z",
+			d:    values.MakeDuration(0, 1),
+			want: "2019-02-15T00:00:00Z",
+		},
+		{
+			name: "end of month",
+			ts:   "2019-01-31T12:00:00Z",
+			d:    values.MakeDuration(0, 1),
+			want: "2019-02-28T12:00:00Z",
+		},
+		{
+			name: "end of month in leap year",
+			ts:   "2020-03-31T00:00:00Z",
+			d:    values.MakeDuration(0, -1),
+			want: "2020-02-29T00:00:00Z",
+		},
+		{
+			name: "leap year",
+			ts:   "2020-02-01T00:00:00Z",
+			d:    values.MakeDuration(int64(28*24*time.Hour), 0),
+			want: "2020-02-29T00:00:00Z",
+		},
+		{
+			name: "year",
+			ts:   "2020-02-01T00:00:00Z",
+			d:    values.MakeDuration(0, 12),
+			want: "2021-02-01T00:00:00Z",
+		},
+		{
+			name: "months and nanoseconds",
+			ts:   "2019-01-01T00:00:00Z",
+			d:    values.MakeDuration(int64(time.Hour), 2),
+			want: "2019-03-01T01:00:00Z",
+		},
+		{
+			name: "negative month",
+			ts:   "2019-03-01T00:00:00Z",
+			d:    values.MakeDuration(0, -1),
+			want: "2019-02-01T00:00:00Z",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := time.Parse(time.RFC3339, tt.ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := values.ConvertTime(ts).Add(tt.d).Time().Format(time.RFC3339)
+			if want := tt.want; want != got {
+				t.Fatalf("unexpected time -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
+
+func TestTime_TruncateMonths(t *testing.T) {
+	for _, tt := range []struct {
+		ts   string
+		d    values.Duration
+		want string
+	}{
+		{
+			ts:   "2019-02-15T12:30:00Z",
+			d:    values.MakeDuration(0, 1),
+			want: "2019-02-01T00:00:00Z",
+		},
+		{
+			ts:   "2019-05-15T00:00:00Z",
+			d:    values.MakeDuration(0, 3),
+			want: "2019-04-01T00:00:00Z",
+		},
+		{
+			ts:   "2019-05-15T00:00:00Z",
+			d:    values.MakeDuration(0, 12),
+			want: "2019-01-01T00:00:00Z",
+		},
+		{
+			ts:   "1969-11-15T00:00:00Z",
+			d:    values.MakeDuration(0, 3),
+			want: "1969-10-01T00:00:00Z",
+		},
+	} {
+		t.Run(tt.ts, func(t *testing.T) {
+			ts, err := time.Parse(time.RFC3339, tt.ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := values.ConvertTime(ts).Truncate(tt.d).Time().Format(time.RFC3339)
+			if want := tt.want; want != got {
+				t.Fatalf("unexpected time -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
+
+func TestTime_InLocation(t *testing.T) {
+	denver, err := time.LoadLocation("America/Denver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kolkata := time.FixedZone("UTC+05:30", 5*60*60+30*60)
+	for _, tt := range []struct {
+		name     string
+		ts       string
+		loc      *time.Location
+		d        values.Duration
+		truncate string
+		add      string
+	}{
+		{
+			name:     "day before daylight saving time",
+			ts:       "2019-03-09T12:00:00-07:00",
+			loc:      denver,
+			d:        values.ConvertDuration(24 * time.Hour),
+			truncate: "2019-03-09T00:00:00-07:00",
+			add:      "2019-03-10T12:00:00-06:00",
+		},
+		{
+			name:     "day of daylight saving time",
+			ts:       "2019-03-10T12:00:00-06:00",
+			loc:      denver,
+			d:        values.ConvertDuration(24 * time.Hour),
+			truncate: "2019-03-10T00:00:00-07:00",
+			add:      "2019-03-11T12:00:00-06:00",
+		},
+		{
+			name:     "day of standard time",
+			ts:       "2019-11-03T12:00:00-07:00",
+			loc:      denver,
+			d:        values.ConvertDuration(24 * time.Hour),
+			truncate: "2019-11-03T00:00:00-06:00",
+			add:      "2019-11-04T12:00:00-07:00",
+		},
+		{
+			name:     "hour across daylight saving time",
+			ts:       "2019-03-10T01:30:00-07:00",
+			loc:      denver,
+			d:        values.ConvertDuration(time.Hour),
+			truncate: "2019-03-10T01:00:00-07:00",
+			add:      "2019-03-10T03:30:00-06:00",
+		},
+		{
+			name:     "month",
+			ts:       "2019-03-31T23:00:00-06:00",
+			loc:      denver,
+			d:        values.MakeDuration(0, 1),
+			truncate: "2019-03-01T00:00:00-07:00",
+			add:      "2019-04-30T23:00:00-06:00",
+		},
+		{
+			name:     "hour in half hour offset",
+			ts:       "2019-03-10T12:45:00+05:30",
+			loc:      kolkata,
+			d:        values.ConvertDuration(time.Hour),
+			truncate: "2019-03-10T12:00:00+05:30",
+			add:      "2019-03-10T13:45:00+05:30",
+		},
+		{
+			name:     "nil location",
+			ts:       "2019-03-10T12:45:00Z",
+			d:        values.ConvertDuration(24 * time.Hour),
+			truncate: "2019-03-10T00:00:00Z",
+			add:      "2019-03-11T12:45:00Z",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			parse := func(s string) values.Time {
+				ts, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return values.ConvertTime(ts)
+			}
+			ts := parse(tt.ts)
+			if want, got := parse(tt.truncate), ts.TruncateIn(tt.d, tt.loc); want != got {
+				t.Errorf("unexpected truncated time -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+			if want, got := parse(tt.add), ts.AddIn(tt.d, tt.loc); want != got {
+				t.Errorf("unexpected added time -want/+got\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for _, tt := range []struct {
+		s       string
+		want    values.Duration
+		str     string
+		wantErr bool
+	}{
+		{
+			s:    "1h30m",
+			want: values.ConvertDuration(time.Hour + 30*time.Minute),
+			str:  "1h30m0s",
+		},
+		{
+			s:    "2d",
+			want: values.ConvertDuration(48 * time.Hour),
+			str:  "48h0m0s",
+		},
+		{
+			s:    "1mo",
+			want: values.MakeDuration(0, 1),
+			str:  "1mo",
+		},
+		{
+			s:    "1y2mo3d",
+			want: values.MakeDuration(int64(72*time.Hour), 14),
+			str:  "1y2mo72h0m0s",
+		},
+		{
+			s:    "-1y",
+			want: values.MakeDuration(0, -12),
+			str:  "-1y",
+		},
+		{
+			s:       "1.5mo",
+			wantErr: true,
+		},
+		{
+			s:       "mo",
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := values.ParseDuration(tt.s)
+			if err != nil {
+				if !tt.wantErr {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			} else if tt.wantErr {
+				t.Fatal("expected error")
+			}
+			if got != tt.want {
+				t.Fatalf("unexpected duration -want/+got\n\t- %v\n\t+ %v", tt.want, got)
+			}
+			if got.String() != tt.str {
+				t.Fatalf("unexpected string -want/+got\n\t- %s\n\t+ %s", tt.str, got.String())
+			}
+			if rt, err := values.ParseDuration(got.String()); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			} else if rt != got {
+				t.Fatalf("duration did not round trip -want/+got\n\t- %v\n\t+ %v", got, rt)
+			}
+		})
+	}
+}